@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/activity"
+	"backend/ent/organization"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ActivityCreate is the builder for creating a Activity entity.
+type ActivityCreate struct {
+	config
+	mutation *ActivityMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (ac *ActivityCreate) SetOrganizationID(u uuid.UUID) *ActivityCreate {
+	ac.mutation.SetOrganizationID(u)
+	return ac
+}
+
+// SetActorID sets the "actor_id" field.
+func (ac *ActivityCreate) SetActorID(u uuid.UUID) *ActivityCreate {
+	ac.mutation.SetActorID(u)
+	return ac
+}
+
+// SetAction sets the "action" field.
+func (ac *ActivityCreate) SetAction(s string) *ActivityCreate {
+	ac.mutation.SetAction(s)
+	return ac
+}
+
+// SetTargetType sets the "target_type" field.
+func (ac *ActivityCreate) SetTargetType(s string) *ActivityCreate {
+	ac.mutation.SetTargetType(s)
+	return ac
+}
+
+// SetTargetID sets the "target_id" field.
+func (ac *ActivityCreate) SetTargetID(u uuid.UUID) *ActivityCreate {
+	ac.mutation.SetTargetID(u)
+	return ac
+}
+
+// SetMetadata sets the "metadata" field.
+func (ac *ActivityCreate) SetMetadata(m map[string]interface{}) *ActivityCreate {
+	ac.mutation.SetMetadata(m)
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *ActivityCreate) SetCreatedAt(t time.Time) *ActivityCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableCreatedAt(t *time.Time) *ActivityCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *ActivityCreate) SetID(u uuid.UUID) *ActivityCreate {
+	ac.mutation.SetID(u)
+	return ac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ac *ActivityCreate) SetNillableID(u *uuid.UUID) *ActivityCreate {
+	if u != nil {
+		ac.SetID(*u)
+	}
+	return ac
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (ac *ActivityCreate) SetOrganization(o *Organization) *ActivityCreate {
+	return ac.SetOrganizationID(o.ID)
+}
+
+// SetActor sets the "actor" edge to the User entity.
+func (ac *ActivityCreate) SetActor(u *User) *ActivityCreate {
+	return ac.SetActorID(u.ID)
+}
+
+// Mutation returns the ActivityMutation object of the builder.
+func (ac *ActivityCreate) Mutation() *ActivityMutation {
+	return ac.mutation
+}
+
+// Save creates the Activity in the database.
+func (ac *ActivityCreate) Save(ctx context.Context) (*Activity, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *ActivityCreate) SaveX(ctx context.Context) *Activity {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *ActivityCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *ActivityCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *ActivityCreate) defaults() {
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := activity.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ac.mutation.ID(); !ok {
+		v := activity.DefaultID()
+		ac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *ActivityCreate) check() error {
+	if _, ok := ac.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "Activity.organization_id"`)}
+	}
+	if _, ok := ac.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "Activity.actor_id"`)}
+	}
+	if _, ok := ac.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Activity.action"`)}
+	}
+	if v, ok := ac.mutation.Action(); ok {
+		if err := activity.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Activity.action": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.TargetType(); !ok {
+		return &ValidationError{Name: "target_type", err: errors.New(`ent: missing required field "Activity.target_type"`)}
+	}
+	if v, ok := ac.mutation.TargetType(); ok {
+		if err := activity.TargetTypeValidator(v); err != nil {
+			return &ValidationError{Name: "target_type", err: fmt.Errorf(`ent: validator failed for field "Activity.target_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "Activity.target_id"`)}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Activity.created_at"`)}
+	}
+	if len(ac.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "Activity.organization"`)}
+	}
+	if len(ac.mutation.ActorIDs()) == 0 {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required edge "Activity.actor"`)}
+	}
+	return nil
+}
+
+func (ac *ActivityCreate) sqlSave(ctx context.Context) (*Activity, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *ActivityCreate) createSpec() (*Activity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Activity{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(activity.Table, sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ac.mutation.Action(); ok {
+		_spec.SetField(activity.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := ac.mutation.TargetType(); ok {
+		_spec.SetField(activity.FieldTargetType, field.TypeString, value)
+		_node.TargetType = value
+	}
+	if value, ok := ac.mutation.TargetID(); ok {
+		_spec.SetField(activity.FieldTargetID, field.TypeUUID, value)
+		_node.TargetID = value
+	}
+	if value, ok := ac.mutation.Metadata(); ok {
+		_spec.SetField(activity.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(activity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ac.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   activity.OrganizationTable,
+			Columns: []string{activity.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ActorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   activity.ActorTable,
+			Columns: []string{activity.ActorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ActorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ActivityCreateBulk is the builder for creating many Activity entities in bulk.
+type ActivityCreateBulk struct {
+	config
+	err      error
+	builders []*ActivityCreate
+}
+
+// Save creates the Activity entities in the database.
+func (acb *ActivityCreateBulk) Save(ctx context.Context) ([]*Activity, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Activity, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ActivityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *ActivityCreateBulk) SaveX(ctx context.Context) []*Activity {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *ActivityCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *ActivityCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
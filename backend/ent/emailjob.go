@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/emailjob"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EmailJob is the model entity for the EmailJob schema.
+type EmailJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// To holds the value of the "to" field.
+	To string `json:"to,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// HTML holds the value of the "html" field.
+	HTML string `json:"html,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Status holds the value of the "status" field.
+	Status emailjob.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailjob.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case emailjob.FieldTo, emailjob.FieldSubject, emailjob.FieldHTML, emailjob.FieldText, emailjob.FieldStatus, emailjob.FieldLastError:
+			values[i] = new(sql.NullString)
+		case emailjob.FieldNextAttemptAt, emailjob.FieldSentAt, emailjob.FieldCreatedAt, emailjob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case emailjob.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailJob fields.
+func (ej *EmailJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailjob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ej.ID = *value
+			}
+		case emailjob.FieldTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to", values[i])
+			} else if value.Valid {
+				ej.To = value.String
+			}
+		case emailjob.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ej.Subject = value.String
+			}
+		case emailjob.FieldHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field html", values[i])
+			} else if value.Valid {
+				ej.HTML = value.String
+			}
+		case emailjob.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				ej.Text = value.String
+			}
+		case emailjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ej.Status = emailjob.Status(value.String)
+			}
+		case emailjob.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				ej.Attempts = int(value.Int64)
+			}
+		case emailjob.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ej.LastError = new(string)
+				*ej.LastError = value.String
+			}
+		case emailjob.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				ej.NextAttemptAt = value.Time
+			}
+		case emailjob.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				ej.SentAt = new(time.Time)
+				*ej.SentAt = value.Time
+			}
+		case emailjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ej.CreatedAt = value.Time
+			}
+		case emailjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ej.UpdatedAt = value.Time
+			}
+		default:
+			ej.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailJob.
+// This includes values selected through modifiers, order, etc.
+func (ej *EmailJob) Value(name string) (ent.Value, error) {
+	return ej.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmailJob.
+// Note that you need to call EmailJob.Unwrap() before calling this method if this EmailJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ej *EmailJob) Update() *EmailJobUpdateOne {
+	return NewEmailJobClient(ej.config).UpdateOne(ej)
+}
+
+// Unwrap unwraps the EmailJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ej *EmailJob) Unwrap() *EmailJob {
+	_tx, ok := ej.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailJob is not a transactional entity")
+	}
+	ej.config.driver = _tx.drv
+	return ej
+}
+
+// String implements the fmt.Stringer.
+func (ej *EmailJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ej.ID))
+	builder.WriteString("to=")
+	builder.WriteString(ej.To)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ej.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("html=")
+	builder.WriteString(ej.HTML)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(ej.Text)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ej.Attempts))
+	builder.WriteString(", ")
+	if v := ej.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(ej.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ej.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ej.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ej.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailJobs is a parsable slice of EmailJob.
+type EmailJobs []*EmailJob
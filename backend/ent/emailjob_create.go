@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/emailjob"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailJobCreate is the builder for creating a EmailJob entity.
+type EmailJobCreate struct {
+	config
+	mutation *EmailJobMutation
+	hooks    []Hook
+}
+
+// SetTo sets the "to" field.
+func (ejc *EmailJobCreate) SetTo(s string) *EmailJobCreate {
+	ejc.mutation.SetTo(s)
+	return ejc
+}
+
+// SetSubject sets the "subject" field.
+func (ejc *EmailJobCreate) SetSubject(s string) *EmailJobCreate {
+	ejc.mutation.SetSubject(s)
+	return ejc
+}
+
+// SetHTML sets the "html" field.
+func (ejc *EmailJobCreate) SetHTML(s string) *EmailJobCreate {
+	ejc.mutation.SetHTML(s)
+	return ejc
+}
+
+// SetText sets the "text" field.
+func (ejc *EmailJobCreate) SetText(s string) *EmailJobCreate {
+	ejc.mutation.SetText(s)
+	return ejc
+}
+
+// SetStatus sets the "status" field.
+func (ejc *EmailJobCreate) SetStatus(e emailjob.Status) *EmailJobCreate {
+	ejc.mutation.SetStatus(e)
+	return ejc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableStatus(e *emailjob.Status) *EmailJobCreate {
+	if e != nil {
+		ejc.SetStatus(*e)
+	}
+	return ejc
+}
+
+// SetAttempts sets the "attempts" field.
+func (ejc *EmailJobCreate) SetAttempts(i int) *EmailJobCreate {
+	ejc.mutation.SetAttempts(i)
+	return ejc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableAttempts(i *int) *EmailJobCreate {
+	if i != nil {
+		ejc.SetAttempts(*i)
+	}
+	return ejc
+}
+
+// SetLastError sets the "last_error" field.
+func (ejc *EmailJobCreate) SetLastError(s string) *EmailJobCreate {
+	ejc.mutation.SetLastError(s)
+	return ejc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableLastError(s *string) *EmailJobCreate {
+	if s != nil {
+		ejc.SetLastError(*s)
+	}
+	return ejc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ejc *EmailJobCreate) SetNextAttemptAt(t time.Time) *EmailJobCreate {
+	ejc.mutation.SetNextAttemptAt(t)
+	return ejc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableNextAttemptAt(t *time.Time) *EmailJobCreate {
+	if t != nil {
+		ejc.SetNextAttemptAt(*t)
+	}
+	return ejc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ejc *EmailJobCreate) SetSentAt(t time.Time) *EmailJobCreate {
+	ejc.mutation.SetSentAt(t)
+	return ejc
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableSentAt(t *time.Time) *EmailJobCreate {
+	if t != nil {
+		ejc.SetSentAt(*t)
+	}
+	return ejc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ejc *EmailJobCreate) SetCreatedAt(t time.Time) *EmailJobCreate {
+	ejc.mutation.SetCreatedAt(t)
+	return ejc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableCreatedAt(t *time.Time) *EmailJobCreate {
+	if t != nil {
+		ejc.SetCreatedAt(*t)
+	}
+	return ejc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ejc *EmailJobCreate) SetUpdatedAt(t time.Time) *EmailJobCreate {
+	ejc.mutation.SetUpdatedAt(t)
+	return ejc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableUpdatedAt(t *time.Time) *EmailJobCreate {
+	if t != nil {
+		ejc.SetUpdatedAt(*t)
+	}
+	return ejc
+}
+
+// SetID sets the "id" field.
+func (ejc *EmailJobCreate) SetID(u uuid.UUID) *EmailJobCreate {
+	ejc.mutation.SetID(u)
+	return ejc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ejc *EmailJobCreate) SetNillableID(u *uuid.UUID) *EmailJobCreate {
+	if u != nil {
+		ejc.SetID(*u)
+	}
+	return ejc
+}
+
+// Mutation returns the EmailJobMutation object of the builder.
+func (ejc *EmailJobCreate) Mutation() *EmailJobMutation {
+	return ejc.mutation
+}
+
+// Save creates the EmailJob in the database.
+func (ejc *EmailJobCreate) Save(ctx context.Context) (*EmailJob, error) {
+	ejc.defaults()
+	return withHooks(ctx, ejc.sqlSave, ejc.mutation, ejc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ejc *EmailJobCreate) SaveX(ctx context.Context) *EmailJob {
+	v, err := ejc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejc *EmailJobCreate) Exec(ctx context.Context) error {
+	_, err := ejc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejc *EmailJobCreate) ExecX(ctx context.Context) {
+	if err := ejc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ejc *EmailJobCreate) defaults() {
+	if _, ok := ejc.mutation.Status(); !ok {
+		v := emailjob.DefaultStatus
+		ejc.mutation.SetStatus(v)
+	}
+	if _, ok := ejc.mutation.Attempts(); !ok {
+		v := emailjob.DefaultAttempts
+		ejc.mutation.SetAttempts(v)
+	}
+	if _, ok := ejc.mutation.NextAttemptAt(); !ok {
+		v := emailjob.DefaultNextAttemptAt()
+		ejc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := ejc.mutation.CreatedAt(); !ok {
+		v := emailjob.DefaultCreatedAt()
+		ejc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ejc.mutation.UpdatedAt(); !ok {
+		v := emailjob.DefaultUpdatedAt()
+		ejc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ejc.mutation.ID(); !ok {
+		v := emailjob.DefaultID()
+		ejc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ejc *EmailJobCreate) check() error {
+	if _, ok := ejc.mutation.To(); !ok {
+		return &ValidationError{Name: "to", err: errors.New(`ent: missing required field "EmailJob.to"`)}
+	}
+	if v, ok := ejc.mutation.To(); ok {
+		if err := emailjob.ToValidator(v); err != nil {
+			return &ValidationError{Name: "to", err: fmt.Errorf(`ent: validator failed for field "EmailJob.to": %w`, err)}
+		}
+	}
+	if _, ok := ejc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "EmailJob.subject"`)}
+	}
+	if _, ok := ejc.mutation.HTML(); !ok {
+		return &ValidationError{Name: "html", err: errors.New(`ent: missing required field "EmailJob.html"`)}
+	}
+	if _, ok := ejc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "EmailJob.text"`)}
+	}
+	if _, ok := ejc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EmailJob.status"`)}
+	}
+	if v, ok := ejc.mutation.Status(); ok {
+		if err := emailjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailJob.status": %w`, err)}
+		}
+	}
+	if _, ok := ejc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "EmailJob.attempts"`)}
+	}
+	if _, ok := ejc.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "EmailJob.next_attempt_at"`)}
+	}
+	if _, ok := ejc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailJob.created_at"`)}
+	}
+	if _, ok := ejc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (ejc *EmailJobCreate) sqlSave(ctx context.Context) (*EmailJob, error) {
+	if err := ejc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ejc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ejc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ejc.mutation.id = &_node.ID
+	ejc.mutation.done = true
+	return _node, nil
+}
+
+func (ejc *EmailJobCreate) createSpec() (*EmailJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailJob{config: ejc.config}
+		_spec = sqlgraph.NewCreateSpec(emailjob.Table, sqlgraph.NewFieldSpec(emailjob.FieldID, field.TypeUUID))
+	)
+	if id, ok := ejc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ejc.mutation.To(); ok {
+		_spec.SetField(emailjob.FieldTo, field.TypeString, value)
+		_node.To = value
+	}
+	if value, ok := ejc.mutation.Subject(); ok {
+		_spec.SetField(emailjob.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := ejc.mutation.HTML(); ok {
+		_spec.SetField(emailjob.FieldHTML, field.TypeString, value)
+		_node.HTML = value
+	}
+	if value, ok := ejc.mutation.Text(); ok {
+		_spec.SetField(emailjob.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := ejc.mutation.Status(); ok {
+		_spec.SetField(emailjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ejc.mutation.Attempts(); ok {
+		_spec.SetField(emailjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ejc.mutation.LastError(); ok {
+		_spec.SetField(emailjob.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := ejc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailjob.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := ejc.mutation.SentAt(); ok {
+		_spec.SetField(emailjob.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := ejc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ejc.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmailJobCreateBulk is the builder for creating many EmailJob entities in bulk.
+type EmailJobCreateBulk struct {
+	config
+	err      error
+	builders []*EmailJobCreate
+}
+
+// Save creates the EmailJob entities in the database.
+func (ejcb *EmailJobCreateBulk) Save(ctx context.Context) ([]*EmailJob, error) {
+	if ejcb.err != nil {
+		return nil, ejcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ejcb.builders))
+	nodes := make([]*EmailJob, len(ejcb.builders))
+	mutators := make([]Mutator, len(ejcb.builders))
+	for i := range ejcb.builders {
+		func(i int, root context.Context) {
+			builder := ejcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ejcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ejcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ejcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejcb *EmailJobCreateBulk) SaveX(ctx context.Context) []*EmailJob {
+	v, err := ejcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ejcb *EmailJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := ejcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejcb *EmailJobCreateBulk) ExecX(ctx context.Context) {
+	if err := ejcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
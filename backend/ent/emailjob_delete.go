@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/emailjob"
+	"backend/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailJobDelete is the builder for deleting a EmailJob entity.
+type EmailJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailJobMutation
+}
+
+// Where appends a list predicates to the EmailJobDelete builder.
+func (ejd *EmailJobDelete) Where(ps ...predicate.EmailJob) *EmailJobDelete {
+	ejd.mutation.Where(ps...)
+	return ejd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ejd *EmailJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ejd.sqlExec, ejd.mutation, ejd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejd *EmailJobDelete) ExecX(ctx context.Context) int {
+	n, err := ejd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ejd *EmailJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailjob.Table, sqlgraph.NewFieldSpec(emailjob.FieldID, field.TypeUUID))
+	if ps := ejd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ejd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ejd.mutation.done = true
+	return affected, err
+}
+
+// EmailJobDeleteOne is the builder for deleting a single EmailJob entity.
+type EmailJobDeleteOne struct {
+	ejd *EmailJobDelete
+}
+
+// Where appends a list predicates to the EmailJobDelete builder.
+func (ejdo *EmailJobDeleteOne) Where(ps ...predicate.EmailJob) *EmailJobDeleteOne {
+	ejdo.ejd.mutation.Where(ps...)
+	return ejdo
+}
+
+// Exec executes the deletion query.
+func (ejdo *EmailJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := ejdo.ejd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejdo *EmailJobDeleteOne) ExecX(ctx context.Context) {
+	if err := ejdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/emailjob"
+	"backend/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EmailJobQuery is the builder for querying EmailJob entities.
+type EmailJobQuery struct {
+	config
+	ctx        *QueryContext
+	order      []emailjob.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EmailJob
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EmailJobQuery builder.
+func (ejq *EmailJobQuery) Where(ps ...predicate.EmailJob) *EmailJobQuery {
+	ejq.predicates = append(ejq.predicates, ps...)
+	return ejq
+}
+
+// Limit the number of records to be returned by this query.
+func (ejq *EmailJobQuery) Limit(limit int) *EmailJobQuery {
+	ejq.ctx.Limit = &limit
+	return ejq
+}
+
+// Offset to start from.
+func (ejq *EmailJobQuery) Offset(offset int) *EmailJobQuery {
+	ejq.ctx.Offset = &offset
+	return ejq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ejq *EmailJobQuery) Unique(unique bool) *EmailJobQuery {
+	ejq.ctx.Unique = &unique
+	return ejq
+}
+
+// Order specifies how the records should be ordered.
+func (ejq *EmailJobQuery) Order(o ...emailjob.OrderOption) *EmailJobQuery {
+	ejq.order = append(ejq.order, o...)
+	return ejq
+}
+
+// First returns the first EmailJob entity from the query.
+// Returns a *NotFoundError when no EmailJob was found.
+func (ejq *EmailJobQuery) First(ctx context.Context) (*EmailJob, error) {
+	nodes, err := ejq.Limit(1).All(setContextOp(ctx, ejq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{emailjob.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ejq *EmailJobQuery) FirstX(ctx context.Context) *EmailJob {
+	node, err := ejq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EmailJob ID from the query.
+// Returns a *NotFoundError when no EmailJob ID was found.
+func (ejq *EmailJobQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ejq.Limit(1).IDs(setContextOp(ctx, ejq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{emailjob.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ejq *EmailJobQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ejq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EmailJob entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EmailJob entity is found.
+// Returns a *NotFoundError when no EmailJob entities are found.
+func (ejq *EmailJobQuery) Only(ctx context.Context) (*EmailJob, error) {
+	nodes, err := ejq.Limit(2).All(setContextOp(ctx, ejq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{emailjob.Label}
+	default:
+		return nil, &NotSingularError{emailjob.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ejq *EmailJobQuery) OnlyX(ctx context.Context) *EmailJob {
+	node, err := ejq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EmailJob ID in the query.
+// Returns a *NotSingularError when more than one EmailJob ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ejq *EmailJobQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ejq.Limit(2).IDs(setContextOp(ctx, ejq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{emailjob.Label}
+	default:
+		err = &NotSingularError{emailjob.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ejq *EmailJobQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ejq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EmailJobs.
+func (ejq *EmailJobQuery) All(ctx context.Context) ([]*EmailJob, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryAll)
+	if err := ejq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EmailJob, *EmailJobQuery]()
+	return withInterceptors[[]*EmailJob](ctx, ejq, qr, ejq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ejq *EmailJobQuery) AllX(ctx context.Context) []*EmailJob {
+	nodes, err := ejq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EmailJob IDs.
+func (ejq *EmailJobQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ejq.ctx.Unique == nil && ejq.path != nil {
+		ejq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryIDs)
+	if err = ejq.Select(emailjob.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ejq *EmailJobQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ejq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ejq *EmailJobQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryCount)
+	if err := ejq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ejq, querierCount[*EmailJobQuery](), ejq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ejq *EmailJobQuery) CountX(ctx context.Context) int {
+	count, err := ejq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ejq *EmailJobQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ejq.ctx, ent.OpQueryExist)
+	switch _, err := ejq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ejq *EmailJobQuery) ExistX(ctx context.Context) bool {
+	exist, err := ejq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EmailJobQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ejq *EmailJobQuery) Clone() *EmailJobQuery {
+	if ejq == nil {
+		return nil
+	}
+	return &EmailJobQuery{
+		config:     ejq.config,
+		ctx:        ejq.ctx.Clone(),
+		order:      append([]emailjob.OrderOption{}, ejq.order...),
+		inters:     append([]Interceptor{}, ejq.inters...),
+		predicates: append([]predicate.EmailJob{}, ejq.predicates...),
+		// clone intermediate query.
+		sql:       ejq.sql.Clone(),
+		path:      ejq.path,
+		modifiers: append([]func(*sql.Selector){}, ejq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		To string `json:"to,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EmailJob.Query().
+//		GroupBy(emailjob.FieldTo).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ejq *EmailJobQuery) GroupBy(field string, fields ...string) *EmailJobGroupBy {
+	ejq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EmailJobGroupBy{build: ejq}
+	grbuild.flds = &ejq.ctx.Fields
+	grbuild.label = emailjob.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		To string `json:"to,omitempty"`
+//	}
+//
+//	client.EmailJob.Query().
+//		Select(emailjob.FieldTo).
+//		Scan(ctx, &v)
+func (ejq *EmailJobQuery) Select(fields ...string) *EmailJobSelect {
+	ejq.ctx.Fields = append(ejq.ctx.Fields, fields...)
+	sbuild := &EmailJobSelect{EmailJobQuery: ejq}
+	sbuild.label = emailjob.Label
+	sbuild.flds, sbuild.scan = &ejq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EmailJobSelect configured with the given aggregations.
+func (ejq *EmailJobQuery) Aggregate(fns ...AggregateFunc) *EmailJobSelect {
+	return ejq.Select().Aggregate(fns...)
+}
+
+func (ejq *EmailJobQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ejq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ejq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ejq.ctx.Fields {
+		if !emailjob.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ejq.path != nil {
+		prev, err := ejq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ejq.sql = prev
+	}
+	return nil
+}
+
+func (ejq *EmailJobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EmailJob, error) {
+	var (
+		nodes = []*EmailJob{}
+		_spec = ejq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EmailJob).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EmailJob{config: ejq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ejq.modifiers) > 0 {
+		_spec.Modifiers = ejq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ejq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ejq *EmailJobQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ejq.querySpec()
+	if len(ejq.modifiers) > 0 {
+		_spec.Modifiers = ejq.modifiers
+	}
+	_spec.Node.Columns = ejq.ctx.Fields
+	if len(ejq.ctx.Fields) > 0 {
+		_spec.Unique = ejq.ctx.Unique != nil && *ejq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ejq.driver, _spec)
+}
+
+func (ejq *EmailJobQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(emailjob.Table, emailjob.Columns, sqlgraph.NewFieldSpec(emailjob.FieldID, field.TypeUUID))
+	_spec.From = ejq.sql
+	if unique := ejq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ejq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ejq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailjob.FieldID)
+		for i := range fields {
+			if fields[i] != emailjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ejq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ejq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ejq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ejq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ejq *EmailJobQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ejq.driver.Dialect())
+	t1 := builder.Table(emailjob.Table)
+	columns := ejq.ctx.Fields
+	if len(columns) == 0 {
+		columns = emailjob.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ejq.sql != nil {
+		selector = ejq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ejq.ctx.Unique != nil && *ejq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ejq.modifiers {
+		m(selector)
+	}
+	for _, p := range ejq.predicates {
+		p(selector)
+	}
+	for _, p := range ejq.order {
+		p(selector)
+	}
+	if offset := ejq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ejq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ejq *EmailJobQuery) Modify(modifiers ...func(s *sql.Selector)) *EmailJobSelect {
+	ejq.modifiers = append(ejq.modifiers, modifiers...)
+	return ejq.Select()
+}
+
+// EmailJobGroupBy is the group-by builder for EmailJob entities.
+type EmailJobGroupBy struct {
+	selector
+	build *EmailJobQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ejgb *EmailJobGroupBy) Aggregate(fns ...AggregateFunc) *EmailJobGroupBy {
+	ejgb.fns = append(ejgb.fns, fns...)
+	return ejgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ejgb *EmailJobGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ejgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ejgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailJobQuery, *EmailJobGroupBy](ctx, ejgb.build, ejgb, ejgb.build.inters, v)
+}
+
+func (ejgb *EmailJobGroupBy) sqlScan(ctx context.Context, root *EmailJobQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ejgb.fns))
+	for _, fn := range ejgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ejgb.flds)+len(ejgb.fns))
+		for _, f := range *ejgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ejgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ejgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EmailJobSelect is the builder for selecting fields of EmailJob entities.
+type EmailJobSelect struct {
+	*EmailJobQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ejs *EmailJobSelect) Aggregate(fns ...AggregateFunc) *EmailJobSelect {
+	ejs.fns = append(ejs.fns, fns...)
+	return ejs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ejs *EmailJobSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ejs.ctx, ent.OpQuerySelect)
+	if err := ejs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EmailJobQuery, *EmailJobSelect](ctx, ejs.EmailJobQuery, ejs, ejs.inters, v)
+}
+
+func (ejs *EmailJobSelect) sqlScan(ctx context.Context, root *EmailJobQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ejs.fns))
+	for _, fn := range ejs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ejs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ejs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ejs *EmailJobSelect) Modify(modifiers ...func(s *sql.Selector)) *EmailJobSelect {
+	ejs.modifiers = append(ejs.modifiers, modifiers...)
+	return ejs
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/emailjob"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailJobUpdate is the builder for updating EmailJob entities.
+type EmailJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EmailJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EmailJobUpdate builder.
+func (eju *EmailJobUpdate) Where(ps ...predicate.EmailJob) *EmailJobUpdate {
+	eju.mutation.Where(ps...)
+	return eju
+}
+
+// SetStatus sets the "status" field.
+func (eju *EmailJobUpdate) SetStatus(e emailjob.Status) *EmailJobUpdate {
+	eju.mutation.SetStatus(e)
+	return eju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eju *EmailJobUpdate) SetNillableStatus(e *emailjob.Status) *EmailJobUpdate {
+	if e != nil {
+		eju.SetStatus(*e)
+	}
+	return eju
+}
+
+// SetAttempts sets the "attempts" field.
+func (eju *EmailJobUpdate) SetAttempts(i int) *EmailJobUpdate {
+	eju.mutation.ResetAttempts()
+	eju.mutation.SetAttempts(i)
+	return eju
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (eju *EmailJobUpdate) SetNillableAttempts(i *int) *EmailJobUpdate {
+	if i != nil {
+		eju.SetAttempts(*i)
+	}
+	return eju
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (eju *EmailJobUpdate) AddAttempts(i int) *EmailJobUpdate {
+	eju.mutation.AddAttempts(i)
+	return eju
+}
+
+// SetLastError sets the "last_error" field.
+func (eju *EmailJobUpdate) SetLastError(s string) *EmailJobUpdate {
+	eju.mutation.SetLastError(s)
+	return eju
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (eju *EmailJobUpdate) SetNillableLastError(s *string) *EmailJobUpdate {
+	if s != nil {
+		eju.SetLastError(*s)
+	}
+	return eju
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (eju *EmailJobUpdate) ClearLastError() *EmailJobUpdate {
+	eju.mutation.ClearLastError()
+	return eju
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (eju *EmailJobUpdate) SetNextAttemptAt(t time.Time) *EmailJobUpdate {
+	eju.mutation.SetNextAttemptAt(t)
+	return eju
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (eju *EmailJobUpdate) SetNillableNextAttemptAt(t *time.Time) *EmailJobUpdate {
+	if t != nil {
+		eju.SetNextAttemptAt(*t)
+	}
+	return eju
+}
+
+// SetSentAt sets the "sent_at" field.
+func (eju *EmailJobUpdate) SetSentAt(t time.Time) *EmailJobUpdate {
+	eju.mutation.SetSentAt(t)
+	return eju
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (eju *EmailJobUpdate) SetNillableSentAt(t *time.Time) *EmailJobUpdate {
+	if t != nil {
+		eju.SetSentAt(*t)
+	}
+	return eju
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (eju *EmailJobUpdate) ClearSentAt() *EmailJobUpdate {
+	eju.mutation.ClearSentAt()
+	return eju
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (eju *EmailJobUpdate) SetUpdatedAt(t time.Time) *EmailJobUpdate {
+	eju.mutation.SetUpdatedAt(t)
+	return eju
+}
+
+// Mutation returns the EmailJobMutation object of the builder.
+func (eju *EmailJobUpdate) Mutation() *EmailJobMutation {
+	return eju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eju *EmailJobUpdate) Save(ctx context.Context) (int, error) {
+	eju.defaults()
+	return withHooks(ctx, eju.sqlSave, eju.mutation, eju.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eju *EmailJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := eju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eju *EmailJobUpdate) Exec(ctx context.Context) error {
+	_, err := eju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eju *EmailJobUpdate) ExecX(ctx context.Context) {
+	if err := eju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eju *EmailJobUpdate) defaults() {
+	if _, ok := eju.mutation.UpdatedAt(); !ok {
+		v := emailjob.UpdateDefaultUpdatedAt()
+		eju.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eju *EmailJobUpdate) check() error {
+	if v, ok := eju.mutation.Status(); ok {
+		if err := emailjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eju *EmailJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailJobUpdate {
+	eju.modifiers = append(eju.modifiers, modifiers...)
+	return eju
+}
+
+func (eju *EmailJobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eju.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailjob.Table, emailjob.Columns, sqlgraph.NewFieldSpec(emailjob.FieldID, field.TypeUUID))
+	if ps := eju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eju.mutation.Status(); ok {
+		_spec.SetField(emailjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eju.mutation.Attempts(); ok {
+		_spec.SetField(emailjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := eju.mutation.LastError(); ok {
+		_spec.SetField(emailjob.FieldLastError, field.TypeString, value)
+	}
+	if eju.mutation.LastErrorCleared() {
+		_spec.ClearField(emailjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := eju.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := eju.mutation.SentAt(); ok {
+		_spec.SetField(emailjob.FieldSentAt, field.TypeTime, value)
+	}
+	if eju.mutation.SentAtCleared() {
+		_spec.ClearField(emailjob.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := eju.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(eju.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eju.mutation.done = true
+	return n, nil
+}
+
+// EmailJobUpdateOne is the builder for updating a single EmailJob entity.
+type EmailJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EmailJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (ejuo *EmailJobUpdateOne) SetStatus(e emailjob.Status) *EmailJobUpdateOne {
+	ejuo.mutation.SetStatus(e)
+	return ejuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ejuo *EmailJobUpdateOne) SetNillableStatus(e *emailjob.Status) *EmailJobUpdateOne {
+	if e != nil {
+		ejuo.SetStatus(*e)
+	}
+	return ejuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (ejuo *EmailJobUpdateOne) SetAttempts(i int) *EmailJobUpdateOne {
+	ejuo.mutation.ResetAttempts()
+	ejuo.mutation.SetAttempts(i)
+	return ejuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ejuo *EmailJobUpdateOne) SetNillableAttempts(i *int) *EmailJobUpdateOne {
+	if i != nil {
+		ejuo.SetAttempts(*i)
+	}
+	return ejuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ejuo *EmailJobUpdateOne) AddAttempts(i int) *EmailJobUpdateOne {
+	ejuo.mutation.AddAttempts(i)
+	return ejuo
+}
+
+// SetLastError sets the "last_error" field.
+func (ejuo *EmailJobUpdateOne) SetLastError(s string) *EmailJobUpdateOne {
+	ejuo.mutation.SetLastError(s)
+	return ejuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ejuo *EmailJobUpdateOne) SetNillableLastError(s *string) *EmailJobUpdateOne {
+	if s != nil {
+		ejuo.SetLastError(*s)
+	}
+	return ejuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ejuo *EmailJobUpdateOne) ClearLastError() *EmailJobUpdateOne {
+	ejuo.mutation.ClearLastError()
+	return ejuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ejuo *EmailJobUpdateOne) SetNextAttemptAt(t time.Time) *EmailJobUpdateOne {
+	ejuo.mutation.SetNextAttemptAt(t)
+	return ejuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ejuo *EmailJobUpdateOne) SetNillableNextAttemptAt(t *time.Time) *EmailJobUpdateOne {
+	if t != nil {
+		ejuo.SetNextAttemptAt(*t)
+	}
+	return ejuo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ejuo *EmailJobUpdateOne) SetSentAt(t time.Time) *EmailJobUpdateOne {
+	ejuo.mutation.SetSentAt(t)
+	return ejuo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ejuo *EmailJobUpdateOne) SetNillableSentAt(t *time.Time) *EmailJobUpdateOne {
+	if t != nil {
+		ejuo.SetSentAt(*t)
+	}
+	return ejuo
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (ejuo *EmailJobUpdateOne) ClearSentAt() *EmailJobUpdateOne {
+	ejuo.mutation.ClearSentAt()
+	return ejuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ejuo *EmailJobUpdateOne) SetUpdatedAt(t time.Time) *EmailJobUpdateOne {
+	ejuo.mutation.SetUpdatedAt(t)
+	return ejuo
+}
+
+// Mutation returns the EmailJobMutation object of the builder.
+func (ejuo *EmailJobUpdateOne) Mutation() *EmailJobMutation {
+	return ejuo.mutation
+}
+
+// Where appends a list predicates to the EmailJobUpdate builder.
+func (ejuo *EmailJobUpdateOne) Where(ps ...predicate.EmailJob) *EmailJobUpdateOne {
+	ejuo.mutation.Where(ps...)
+	return ejuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ejuo *EmailJobUpdateOne) Select(field string, fields ...string) *EmailJobUpdateOne {
+	ejuo.fields = append([]string{field}, fields...)
+	return ejuo
+}
+
+// Save executes the query and returns the updated EmailJob entity.
+func (ejuo *EmailJobUpdateOne) Save(ctx context.Context) (*EmailJob, error) {
+	ejuo.defaults()
+	return withHooks(ctx, ejuo.sqlSave, ejuo.mutation, ejuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ejuo *EmailJobUpdateOne) SaveX(ctx context.Context) *EmailJob {
+	node, err := ejuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ejuo *EmailJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := ejuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ejuo *EmailJobUpdateOne) ExecX(ctx context.Context) {
+	if err := ejuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ejuo *EmailJobUpdateOne) defaults() {
+	if _, ok := ejuo.mutation.UpdatedAt(); !ok {
+		v := emailjob.UpdateDefaultUpdatedAt()
+		ejuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ejuo *EmailJobUpdateOne) check() error {
+	if v, ok := ejuo.mutation.Status(); ok {
+		if err := emailjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EmailJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ejuo *EmailJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EmailJobUpdateOne {
+	ejuo.modifiers = append(ejuo.modifiers, modifiers...)
+	return ejuo
+}
+
+func (ejuo *EmailJobUpdateOne) sqlSave(ctx context.Context) (_node *EmailJob, err error) {
+	if err := ejuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailjob.Table, emailjob.Columns, sqlgraph.NewFieldSpec(emailjob.FieldID, field.TypeUUID))
+	id, ok := ejuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ejuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailjob.FieldID)
+		for _, f := range fields {
+			if !emailjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ejuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ejuo.mutation.Status(); ok {
+		_spec.SetField(emailjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ejuo.mutation.Attempts(); ok {
+		_spec.SetField(emailjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(emailjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ejuo.mutation.LastError(); ok {
+		_spec.SetField(emailjob.FieldLastError, field.TypeString, value)
+	}
+	if ejuo.mutation.LastErrorCleared() {
+		_spec.ClearField(emailjob.FieldLastError, field.TypeString)
+	}
+	if value, ok := ejuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(emailjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := ejuo.mutation.SentAt(); ok {
+		_spec.SetField(emailjob.FieldSentAt, field.TypeTime, value)
+	}
+	if ejuo.mutation.SentAtCleared() {
+		_spec.ClearField(emailjob.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := ejuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(ejuo.modifiers...)
+	_node = &EmailJob{config: ejuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ejuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ejuo.mutation.done = true
+	return _node, nil
+}
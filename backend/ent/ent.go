@@ -3,12 +3,25 @@
 package ent
 
 import (
+	"backend/ent/activity"
+	"backend/ent/apikey"
+	"backend/ent/attachment"
+	"backend/ent/comment"
+	"backend/ent/emailjob"
 	"backend/ent/invite"
+	"backend/ent/label"
+	"backend/ent/notification"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/revokedtoken"
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/taskwatcher"
 	"backend/ent/user"
+	"backend/ent/webhook"
+	"backend/ent/webhookdelivery"
 	"context"
 	"errors"
 	"fmt"
@@ -78,12 +91,25 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikey.Table:             apikey.ValidColumn,
+			activity.Table:           activity.ValidColumn,
+			attachment.Table:         attachment.ValidColumn,
+			comment.Table:            comment.ValidColumn,
+			emailjob.Table:           emailjob.ValidColumn,
 			invite.Table:             invite.ValidColumn,
+			label.Table:              label.ValidColumn,
+			notification.Table:       notification.ValidColumn,
 			organization.Table:       organization.ValidColumn,
 			organizationmember.Table: organizationmember.ValidColumn,
 			project.Table:            project.ValidColumn,
 			projectmember.Table:      projectmember.ValidColumn,
+			revokedtoken.Table:       revokedtoken.ValidColumn,
+			task.Table:               task.ValidColumn,
+			taskstatuschange.Table:   taskstatuschange.ValidColumn,
+			taskwatcher.Table:        taskwatcher.ValidColumn,
 			user.Table:               user.ValidColumn,
+			webhook.Table:            webhook.ValidColumn,
+			webhookdelivery.Table:    webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -29,6 +29,7 @@ type InviteQuery struct {
 	withOrganization *OrganizationQuery
 	withProject      *ProjectQuery
 	withInvitedBy    *UserQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -327,8 +328,9 @@ func (iq *InviteQuery) Clone() *InviteQuery {
 		withProject:      iq.withProject.Clone(),
 		withInvitedBy:    iq.withInvitedBy.Clone(),
 		// clone intermediate query.
-		sql:  iq.sql.Clone(),
-		path: iq.path,
+		sql:       iq.sql.Clone(),
+		path:      iq.path,
+		modifiers: append([]func(*sql.Selector){}, iq.modifiers...),
 	}
 }
 
@@ -458,6 +460,9 @@ func (iq *InviteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Invit
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -581,6 +586,9 @@ func (iq *InviteQuery) loadInvitedBy(ctx context.Context, query *UserQuery, node
 
 func (iq *InviteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := iq.querySpec()
+	if len(iq.modifiers) > 0 {
+		_spec.Modifiers = iq.modifiers
+	}
 	_spec.Node.Columns = iq.ctx.Fields
 	if len(iq.ctx.Fields) > 0 {
 		_spec.Unique = iq.ctx.Unique != nil && *iq.ctx.Unique
@@ -652,6 +660,9 @@ func (iq *InviteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if iq.ctx.Unique != nil && *iq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range iq.modifiers {
+		m(selector)
+	}
 	for _, p := range iq.predicates {
 		p(selector)
 	}
@@ -669,6 +680,12 @@ func (iq *InviteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (iq *InviteQuery) Modify(modifiers ...func(s *sql.Selector)) *InviteSelect {
+	iq.modifiers = append(iq.modifiers, modifiers...)
+	return iq.Select()
+}
+
 // InviteGroupBy is the group-by builder for Invite entities.
 type InviteGroupBy struct {
 	selector
@@ -758,3 +775,9 @@ func (is *InviteSelect) sqlScan(ctx context.Context, root *InviteQuery, v any) e
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (is *InviteSelect) Modify(modifiers ...func(s *sql.Selector)) *InviteSelect {
+	is.modifiers = append(is.modifiers, modifiers...)
+	return is
+}
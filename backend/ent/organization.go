@@ -4,6 +4,7 @@ package ent
 
 import (
 	"backend/ent/organization"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -22,6 +23,12 @@ type Organization struct {
 	Name string `json:"name,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
+	// DefaultProjectPermission holds the value of the "default_project_permission" field.
+	DefaultProjectPermission organization.DefaultProjectPermission `json:"default_project_permission,omitempty"`
+	// MaxMembers holds the value of the "max_members" field.
+	MaxMembers *int `json:"max_members,omitempty"`
+	// FeatureFlags holds the value of the "feature_flags" field.
+	FeatureFlags map[string]bool `json:"feature_flags,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -40,13 +47,19 @@ type OrganizationEdges struct {
 	Projects []*Project `json:"projects,omitempty"`
 	// Invites holds the value of the invites edge.
 	Invites []*Invite `json:"invites,omitempty"`
+	// Activities holds the value of the activities edge.
+	Activities []*Activity `json:"activities,omitempty"`
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
 	// LastAccessedBy holds the value of the last_accessed_by edge.
 	LastAccessedBy []*User `json:"last_accessed_by,omitempty"`
 	// OrganizationMemberships holds the value of the organization_memberships edge.
 	OrganizationMemberships []*OrganizationMember `json:"organization_memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // MembersOrErr returns the Members value or an error if the edge
@@ -76,10 +89,37 @@ func (e OrganizationEdges) InvitesOrErr() ([]*Invite, error) {
 	return nil, &NotLoadedError{edge: "invites"}
 }
 
+// ActivitiesOrErr returns the Activities value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) ActivitiesOrErr() ([]*Activity, error) {
+	if e.loadedTypes[3] {
+		return e.Activities, nil
+	}
+	return nil, &NotLoadedError{edge: "activities"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[4] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[5] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
 // LastAccessedByOrErr returns the LastAccessedBy value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) LastAccessedByOrErr() ([]*User, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.LastAccessedBy, nil
 	}
 	return nil, &NotLoadedError{edge: "last_accessed_by"}
@@ -88,7 +128,7 @@ func (e OrganizationEdges) LastAccessedByOrErr() ([]*User, error) {
 // OrganizationMembershipsOrErr returns the OrganizationMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[7] {
 		return e.OrganizationMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "organization_memberships"}
@@ -99,7 +139,11 @@ func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organization.FieldName, organization.FieldSlug:
+		case organization.FieldFeatureFlags:
+			values[i] = new([]byte)
+		case organization.FieldMaxMembers:
+			values[i] = new(sql.NullInt64)
+		case organization.FieldName, organization.FieldSlug, organization.FieldDefaultProjectPermission:
 			values[i] = new(sql.NullString)
 		case organization.FieldCreatedAt, organization.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -138,6 +182,27 @@ func (o *Organization) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.Slug = value.String
 			}
+		case organization.FieldDefaultProjectPermission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_project_permission", values[i])
+			} else if value.Valid {
+				o.DefaultProjectPermission = organization.DefaultProjectPermission(value.String)
+			}
+		case organization.FieldMaxMembers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_members", values[i])
+			} else if value.Valid {
+				o.MaxMembers = new(int)
+				*o.MaxMembers = int(value.Int64)
+			}
+		case organization.FieldFeatureFlags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field feature_flags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.FeatureFlags); err != nil {
+					return fmt.Errorf("unmarshal field feature_flags: %w", err)
+				}
+			}
 		case organization.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -178,6 +243,21 @@ func (o *Organization) QueryInvites() *InviteQuery {
 	return NewOrganizationClient(o.config).QueryInvites(o)
 }
 
+// QueryActivities queries the "activities" edge of the Organization entity.
+func (o *Organization) QueryActivities() *ActivityQuery {
+	return NewOrganizationClient(o.config).QueryActivities(o)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Organization entity.
+func (o *Organization) QueryWebhooks() *WebhookQuery {
+	return NewOrganizationClient(o.config).QueryWebhooks(o)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the Organization entity.
+func (o *Organization) QueryAPIKeys() *APIKeyQuery {
+	return NewOrganizationClient(o.config).QueryAPIKeys(o)
+}
+
 // QueryLastAccessedBy queries the "last_accessed_by" edge of the Organization entity.
 func (o *Organization) QueryLastAccessedBy() *UserQuery {
 	return NewOrganizationClient(o.config).QueryLastAccessedBy(o)
@@ -217,6 +297,17 @@ func (o *Organization) String() string {
 	builder.WriteString("slug=")
 	builder.WriteString(o.Slug)
 	builder.WriteString(", ")
+	builder.WriteString("default_project_permission=")
+	builder.WriteString(fmt.Sprintf("%v", o.DefaultProjectPermission))
+	builder.WriteString(", ")
+	if v := o.MaxMembers; v != nil {
+		builder.WriteString("max_members=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("feature_flags=")
+	builder.WriteString(fmt.Sprintf("%v", o.FeatureFlags))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -66,6 +66,11 @@ func Slug(v string) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldSlug, v))
 }
 
+// MaxMembers applies equality check predicate on the "max_members" field. It's identical to MaxMembersEQ.
+func MaxMembers(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxMembers, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -206,6 +211,86 @@ func SlugContainsFold(v string) predicate.Organization {
 	return predicate.Organization(sql.FieldContainsFold(FieldSlug, v))
 }
 
+// DefaultProjectPermissionEQ applies the EQ predicate on the "default_project_permission" field.
+func DefaultProjectPermissionEQ(v DefaultProjectPermission) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldDefaultProjectPermission, v))
+}
+
+// DefaultProjectPermissionNEQ applies the NEQ predicate on the "default_project_permission" field.
+func DefaultProjectPermissionNEQ(v DefaultProjectPermission) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldDefaultProjectPermission, v))
+}
+
+// DefaultProjectPermissionIn applies the In predicate on the "default_project_permission" field.
+func DefaultProjectPermissionIn(vs ...DefaultProjectPermission) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldDefaultProjectPermission, vs...))
+}
+
+// DefaultProjectPermissionNotIn applies the NotIn predicate on the "default_project_permission" field.
+func DefaultProjectPermissionNotIn(vs ...DefaultProjectPermission) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldDefaultProjectPermission, vs...))
+}
+
+// MaxMembersEQ applies the EQ predicate on the "max_members" field.
+func MaxMembersEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldEQ(FieldMaxMembers, v))
+}
+
+// MaxMembersNEQ applies the NEQ predicate on the "max_members" field.
+func MaxMembersNEQ(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldNEQ(FieldMaxMembers, v))
+}
+
+// MaxMembersIn applies the In predicate on the "max_members" field.
+func MaxMembersIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldIn(FieldMaxMembers, vs...))
+}
+
+// MaxMembersNotIn applies the NotIn predicate on the "max_members" field.
+func MaxMembersNotIn(vs ...int) predicate.Organization {
+	return predicate.Organization(sql.FieldNotIn(FieldMaxMembers, vs...))
+}
+
+// MaxMembersGT applies the GT predicate on the "max_members" field.
+func MaxMembersGT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGT(FieldMaxMembers, v))
+}
+
+// MaxMembersGTE applies the GTE predicate on the "max_members" field.
+func MaxMembersGTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldGTE(FieldMaxMembers, v))
+}
+
+// MaxMembersLT applies the LT predicate on the "max_members" field.
+func MaxMembersLT(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLT(FieldMaxMembers, v))
+}
+
+// MaxMembersLTE applies the LTE predicate on the "max_members" field.
+func MaxMembersLTE(v int) predicate.Organization {
+	return predicate.Organization(sql.FieldLTE(FieldMaxMembers, v))
+}
+
+// MaxMembersIsNil applies the IsNil predicate on the "max_members" field.
+func MaxMembersIsNil() predicate.Organization {
+	return predicate.Organization(sql.FieldIsNull(FieldMaxMembers))
+}
+
+// MaxMembersNotNil applies the NotNil predicate on the "max_members" field.
+func MaxMembersNotNil() predicate.Organization {
+	return predicate.Organization(sql.FieldNotNull(FieldMaxMembers))
+}
+
+// FeatureFlagsIsNil applies the IsNil predicate on the "feature_flags" field.
+func FeatureFlagsIsNil() predicate.Organization {
+	return predicate.Organization(sql.FieldIsNull(FieldFeatureFlags))
+}
+
+// FeatureFlagsNotNil applies the NotNil predicate on the "feature_flags" field.
+func FeatureFlagsNotNil() predicate.Organization {
+	return predicate.Organization(sql.FieldNotNull(FieldFeatureFlags))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Organization {
 	return predicate.Organization(sql.FieldEQ(FieldCreatedAt, v))
@@ -355,6 +440,75 @@ func HasInvitesWith(preds ...predicate.Invite) predicate.Organization {
 	})
 }
 
+// HasActivities applies the HasEdge predicate on the "activities" edge.
+func HasActivities() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ActivitiesTable, ActivitiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActivitiesWith applies the HasEdge predicate on the "activities" edge with a given conditions (other predicates).
+func HasActivitiesWith(preds ...predicate.Activity) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newActivitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
+func HasAPIKeys() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAPIKeysWith applies the HasEdge predicate on the "api_keys" edge with a given conditions (other predicates).
+func HasAPIKeysWith(preds ...predicate.APIKey) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newAPIKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasLastAccessedBy applies the HasEdge predicate on the "last_accessed_by" edge.
 func HasLastAccessedBy() predicate.Organization {
 	return predicate.Organization(func(s *sql.Selector) {
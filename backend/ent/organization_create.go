@@ -3,11 +3,14 @@
 package ent
 
 import (
+	"backend/ent/activity"
+	"backend/ent/apikey"
 	"backend/ent/invite"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/project"
 	"backend/ent/user"
+	"backend/ent/webhook"
 	"context"
 	"errors"
 	"fmt"
@@ -37,6 +40,40 @@ func (oc *OrganizationCreate) SetSlug(s string) *OrganizationCreate {
 	return oc
 }
 
+// SetDefaultProjectPermission sets the "default_project_permission" field.
+func (oc *OrganizationCreate) SetDefaultProjectPermission(opp organization.DefaultProjectPermission) *OrganizationCreate {
+	oc.mutation.SetDefaultProjectPermission(opp)
+	return oc
+}
+
+// SetNillableDefaultProjectPermission sets the "default_project_permission" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableDefaultProjectPermission(opp *organization.DefaultProjectPermission) *OrganizationCreate {
+	if opp != nil {
+		oc.SetDefaultProjectPermission(*opp)
+	}
+	return oc
+}
+
+// SetMaxMembers sets the "max_members" field.
+func (oc *OrganizationCreate) SetMaxMembers(i int) *OrganizationCreate {
+	oc.mutation.SetMaxMembers(i)
+	return oc
+}
+
+// SetNillableMaxMembers sets the "max_members" field if the given value is not nil.
+func (oc *OrganizationCreate) SetNillableMaxMembers(i *int) *OrganizationCreate {
+	if i != nil {
+		oc.SetMaxMembers(*i)
+	}
+	return oc
+}
+
+// SetFeatureFlags sets the "feature_flags" field.
+func (oc *OrganizationCreate) SetFeatureFlags(m map[string]bool) *OrganizationCreate {
+	oc.mutation.SetFeatureFlags(m)
+	return oc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (oc *OrganizationCreate) SetCreatedAt(t time.Time) *OrganizationCreate {
 	oc.mutation.SetCreatedAt(t)
@@ -124,6 +161,51 @@ func (oc *OrganizationCreate) AddInvites(i ...*Invite) *OrganizationCreate {
 	return oc.AddInviteIDs(ids...)
 }
 
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (oc *OrganizationCreate) AddActivityIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddActivityIDs(ids...)
+	return oc
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (oc *OrganizationCreate) AddActivities(a ...*Activity) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return oc.AddActivityIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (oc *OrganizationCreate) AddWebhookIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddWebhookIDs(ids...)
+	return oc
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (oc *OrganizationCreate) AddWebhooks(w ...*Webhook) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return oc.AddWebhookIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (oc *OrganizationCreate) AddAPIKeyIDs(ids ...uuid.UUID) *OrganizationCreate {
+	oc.mutation.AddAPIKeyIDs(ids...)
+	return oc
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (oc *OrganizationCreate) AddAPIKeys(a ...*APIKey) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return oc.AddAPIKeyIDs(ids...)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (oc *OrganizationCreate) AddLastAccessedByIDs(ids ...uuid.UUID) *OrganizationCreate {
 	oc.mutation.AddLastAccessedByIDs(ids...)
@@ -189,6 +271,10 @@ func (oc *OrganizationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (oc *OrganizationCreate) defaults() {
+	if _, ok := oc.mutation.DefaultProjectPermission(); !ok {
+		v := organization.DefaultDefaultProjectPermission
+		oc.mutation.SetDefaultProjectPermission(v)
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		v := organization.DefaultCreatedAt()
 		oc.mutation.SetCreatedAt(v)
@@ -221,6 +307,19 @@ func (oc *OrganizationCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organization.slug": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.DefaultProjectPermission(); !ok {
+		return &ValidationError{Name: "default_project_permission", err: errors.New(`ent: missing required field "Organization.default_project_permission"`)}
+	}
+	if v, ok := oc.mutation.DefaultProjectPermission(); ok {
+		if err := organization.DefaultProjectPermissionValidator(v); err != nil {
+			return &ValidationError{Name: "default_project_permission", err: fmt.Errorf(`ent: validator failed for field "Organization.default_project_permission": %w`, err)}
+		}
+	}
+	if v, ok := oc.mutation.MaxMembers(); ok {
+		if err := organization.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "max_members", err: fmt.Errorf(`ent: validator failed for field "Organization.max_members": %w`, err)}
+		}
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Organization.created_at"`)}
 	}
@@ -270,6 +369,18 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldSlug, field.TypeString, value)
 		_node.Slug = value
 	}
+	if value, ok := oc.mutation.DefaultProjectPermission(); ok {
+		_spec.SetField(organization.FieldDefaultProjectPermission, field.TypeEnum, value)
+		_node.DefaultProjectPermission = value
+	}
+	if value, ok := oc.mutation.MaxMembers(); ok {
+		_spec.SetField(organization.FieldMaxMembers, field.TypeInt, value)
+		_node.MaxMembers = &value
+	}
+	if value, ok := oc.mutation.FeatureFlags(); ok {
+		_spec.SetField(organization.FieldFeatureFlags, field.TypeJSON, value)
+		_node.FeatureFlags = value
+	}
 	if value, ok := oc.mutation.CreatedAt(); ok {
 		_spec.SetField(organization.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -330,6 +441,54 @@ func (oc *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := oc.mutation.LastAccessedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
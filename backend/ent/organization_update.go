@@ -3,12 +3,15 @@
 package ent
 
 import (
+	"backend/ent/activity"
+	"backend/ent/apikey"
 	"backend/ent/invite"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/predicate"
 	"backend/ent/project"
 	"backend/ent/user"
+	"backend/ent/webhook"
 	"context"
 	"errors"
 	"fmt"
@@ -23,8 +26,9 @@ import (
 // OrganizationUpdate is the builder for updating Organization entities.
 type OrganizationUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OrganizationMutation
+	hooks     []Hook
+	mutation  *OrganizationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OrganizationUpdate builder.
@@ -61,6 +65,59 @@ func (ou *OrganizationUpdate) SetNillableSlug(s *string) *OrganizationUpdate {
 	return ou
 }
 
+// SetDefaultProjectPermission sets the "default_project_permission" field.
+func (ou *OrganizationUpdate) SetDefaultProjectPermission(opp organization.DefaultProjectPermission) *OrganizationUpdate {
+	ou.mutation.SetDefaultProjectPermission(opp)
+	return ou
+}
+
+// SetNillableDefaultProjectPermission sets the "default_project_permission" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableDefaultProjectPermission(opp *organization.DefaultProjectPermission) *OrganizationUpdate {
+	if opp != nil {
+		ou.SetDefaultProjectPermission(*opp)
+	}
+	return ou
+}
+
+// SetMaxMembers sets the "max_members" field.
+func (ou *OrganizationUpdate) SetMaxMembers(i int) *OrganizationUpdate {
+	ou.mutation.ResetMaxMembers()
+	ou.mutation.SetMaxMembers(i)
+	return ou
+}
+
+// SetNillableMaxMembers sets the "max_members" field if the given value is not nil.
+func (ou *OrganizationUpdate) SetNillableMaxMembers(i *int) *OrganizationUpdate {
+	if i != nil {
+		ou.SetMaxMembers(*i)
+	}
+	return ou
+}
+
+// AddMaxMembers adds i to the "max_members" field.
+func (ou *OrganizationUpdate) AddMaxMembers(i int) *OrganizationUpdate {
+	ou.mutation.AddMaxMembers(i)
+	return ou
+}
+
+// ClearMaxMembers clears the value of the "max_members" field.
+func (ou *OrganizationUpdate) ClearMaxMembers() *OrganizationUpdate {
+	ou.mutation.ClearMaxMembers()
+	return ou
+}
+
+// SetFeatureFlags sets the "feature_flags" field.
+func (ou *OrganizationUpdate) SetFeatureFlags(m map[string]bool) *OrganizationUpdate {
+	ou.mutation.SetFeatureFlags(m)
+	return ou
+}
+
+// ClearFeatureFlags clears the value of the "feature_flags" field.
+func (ou *OrganizationUpdate) ClearFeatureFlags() *OrganizationUpdate {
+	ou.mutation.ClearFeatureFlags()
+	return ou
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ou *OrganizationUpdate) SetUpdatedAt(t time.Time) *OrganizationUpdate {
 	ou.mutation.SetUpdatedAt(t)
@@ -112,6 +169,51 @@ func (ou *OrganizationUpdate) AddInvites(i ...*Invite) *OrganizationUpdate {
 	return ou.AddInviteIDs(ids...)
 }
 
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (ou *OrganizationUpdate) AddActivityIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddActivityIDs(ids...)
+	return ou
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (ou *OrganizationUpdate) AddActivities(a ...*Activity) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.AddActivityIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (ou *OrganizationUpdate) AddWebhookIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddWebhookIDs(ids...)
+	return ou
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (ou *OrganizationUpdate) AddWebhooks(w ...*Webhook) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.AddWebhookIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ou *OrganizationUpdate) AddAPIKeyIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.AddAPIKeyIDs(ids...)
+	return ou
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ou *OrganizationUpdate) AddAPIKeys(a ...*APIKey) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.AddAPIKeyIDs(ids...)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (ou *OrganizationUpdate) AddLastAccessedByIDs(ids ...uuid.UUID) *OrganizationUpdate {
 	ou.mutation.AddLastAccessedByIDs(ids...)
@@ -210,6 +312,69 @@ func (ou *OrganizationUpdate) RemoveInvites(i ...*Invite) *OrganizationUpdate {
 	return ou.RemoveInviteIDs(ids...)
 }
 
+// ClearActivities clears all "activities" edges to the Activity entity.
+func (ou *OrganizationUpdate) ClearActivities() *OrganizationUpdate {
+	ou.mutation.ClearActivities()
+	return ou
+}
+
+// RemoveActivityIDs removes the "activities" edge to Activity entities by IDs.
+func (ou *OrganizationUpdate) RemoveActivityIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemoveActivityIDs(ids...)
+	return ou
+}
+
+// RemoveActivities removes "activities" edges to Activity entities.
+func (ou *OrganizationUpdate) RemoveActivities(a ...*Activity) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.RemoveActivityIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (ou *OrganizationUpdate) ClearWebhooks() *OrganizationUpdate {
+	ou.mutation.ClearWebhooks()
+	return ou
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (ou *OrganizationUpdate) RemoveWebhookIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemoveWebhookIDs(ids...)
+	return ou
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (ou *OrganizationUpdate) RemoveWebhooks(w ...*Webhook) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.RemoveWebhookIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ou *OrganizationUpdate) ClearAPIKeys() *OrganizationUpdate {
+	ou.mutation.ClearAPIKeys()
+	return ou
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ou *OrganizationUpdate) RemoveAPIKeyIDs(ids ...uuid.UUID) *OrganizationUpdate {
+	ou.mutation.RemoveAPIKeyIDs(ids...)
+	return ou
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ou *OrganizationUpdate) RemoveAPIKeys(a ...*APIKey) *OrganizationUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ou.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearLastAccessedBy clears all "last_accessed_by" edges to the User entity.
 func (ou *OrganizationUpdate) ClearLastAccessedBy() *OrganizationUpdate {
 	ou.mutation.ClearLastAccessedBy()
@@ -300,9 +465,25 @@ func (ou *OrganizationUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organization.slug": %w`, err)}
 		}
 	}
+	if v, ok := ou.mutation.DefaultProjectPermission(); ok {
+		if err := organization.DefaultProjectPermissionValidator(v); err != nil {
+			return &ValidationError{Name: "default_project_permission", err: fmt.Errorf(`ent: validator failed for field "Organization.default_project_permission": %w`, err)}
+		}
+	}
+	if v, ok := ou.mutation.MaxMembers(); ok {
+		if err := organization.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "max_members", err: fmt.Errorf(`ent: validator failed for field "Organization.max_members": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ou *OrganizationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationUpdate {
+	ou.modifiers = append(ou.modifiers, modifiers...)
+	return ou
+}
+
 func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := ou.check(); err != nil {
 		return n, err
@@ -321,6 +502,24 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.Slug(); ok {
 		_spec.SetField(organization.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := ou.mutation.DefaultProjectPermission(); ok {
+		_spec.SetField(organization.FieldDefaultProjectPermission, field.TypeEnum, value)
+	}
+	if value, ok := ou.mutation.MaxMembers(); ok {
+		_spec.SetField(organization.FieldMaxMembers, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedMaxMembers(); ok {
+		_spec.AddField(organization.FieldMaxMembers, field.TypeInt, value)
+	}
+	if ou.mutation.MaxMembersCleared() {
+		_spec.ClearField(organization.FieldMaxMembers, field.TypeInt)
+	}
+	if value, ok := ou.mutation.FeatureFlags(); ok {
+		_spec.SetField(organization.FieldFeatureFlags, field.TypeJSON, value)
+	}
+	if ou.mutation.FeatureFlagsCleared() {
+		_spec.ClearField(organization.FieldFeatureFlags, field.TypeJSON)
+	}
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -471,6 +670,141 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !ou.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !ou.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ou.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ou.mutation.LastAccessedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -561,6 +895,7 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ou.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organization.Label}
@@ -576,9 +911,10 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // OrganizationUpdateOne is the builder for updating a single Organization entity.
 type OrganizationUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OrganizationMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *OrganizationMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -609,6 +945,59 @@ func (ouo *OrganizationUpdateOne) SetNillableSlug(s *string) *OrganizationUpdate
 	return ouo
 }
 
+// SetDefaultProjectPermission sets the "default_project_permission" field.
+func (ouo *OrganizationUpdateOne) SetDefaultProjectPermission(opp organization.DefaultProjectPermission) *OrganizationUpdateOne {
+	ouo.mutation.SetDefaultProjectPermission(opp)
+	return ouo
+}
+
+// SetNillableDefaultProjectPermission sets the "default_project_permission" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableDefaultProjectPermission(opp *organization.DefaultProjectPermission) *OrganizationUpdateOne {
+	if opp != nil {
+		ouo.SetDefaultProjectPermission(*opp)
+	}
+	return ouo
+}
+
+// SetMaxMembers sets the "max_members" field.
+func (ouo *OrganizationUpdateOne) SetMaxMembers(i int) *OrganizationUpdateOne {
+	ouo.mutation.ResetMaxMembers()
+	ouo.mutation.SetMaxMembers(i)
+	return ouo
+}
+
+// SetNillableMaxMembers sets the "max_members" field if the given value is not nil.
+func (ouo *OrganizationUpdateOne) SetNillableMaxMembers(i *int) *OrganizationUpdateOne {
+	if i != nil {
+		ouo.SetMaxMembers(*i)
+	}
+	return ouo
+}
+
+// AddMaxMembers adds i to the "max_members" field.
+func (ouo *OrganizationUpdateOne) AddMaxMembers(i int) *OrganizationUpdateOne {
+	ouo.mutation.AddMaxMembers(i)
+	return ouo
+}
+
+// ClearMaxMembers clears the value of the "max_members" field.
+func (ouo *OrganizationUpdateOne) ClearMaxMembers() *OrganizationUpdateOne {
+	ouo.mutation.ClearMaxMembers()
+	return ouo
+}
+
+// SetFeatureFlags sets the "feature_flags" field.
+func (ouo *OrganizationUpdateOne) SetFeatureFlags(m map[string]bool) *OrganizationUpdateOne {
+	ouo.mutation.SetFeatureFlags(m)
+	return ouo
+}
+
+// ClearFeatureFlags clears the value of the "feature_flags" field.
+func (ouo *OrganizationUpdateOne) ClearFeatureFlags() *OrganizationUpdateOne {
+	ouo.mutation.ClearFeatureFlags()
+	return ouo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (ouo *OrganizationUpdateOne) SetUpdatedAt(t time.Time) *OrganizationUpdateOne {
 	ouo.mutation.SetUpdatedAt(t)
@@ -660,6 +1049,51 @@ func (ouo *OrganizationUpdateOne) AddInvites(i ...*Invite) *OrganizationUpdateOn
 	return ouo.AddInviteIDs(ids...)
 }
 
+// AddActivityIDs adds the "activities" edge to the Activity entity by IDs.
+func (ouo *OrganizationUpdateOne) AddActivityIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddActivityIDs(ids...)
+	return ouo
+}
+
+// AddActivities adds the "activities" edges to the Activity entity.
+func (ouo *OrganizationUpdateOne) AddActivities(a ...*Activity) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.AddActivityIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (ouo *OrganizationUpdateOne) AddWebhookIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddWebhookIDs(ids...)
+	return ouo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (ouo *OrganizationUpdateOne) AddWebhooks(w ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.AddWebhookIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (ouo *OrganizationUpdateOne) AddAPIKeyIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.AddAPIKeyIDs(ids...)
+	return ouo
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (ouo *OrganizationUpdateOne) AddAPIKeys(a ...*APIKey) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.AddAPIKeyIDs(ids...)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (ouo *OrganizationUpdateOne) AddLastAccessedByIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
 	ouo.mutation.AddLastAccessedByIDs(ids...)
@@ -758,6 +1192,69 @@ func (ouo *OrganizationUpdateOne) RemoveInvites(i ...*Invite) *OrganizationUpdat
 	return ouo.RemoveInviteIDs(ids...)
 }
 
+// ClearActivities clears all "activities" edges to the Activity entity.
+func (ouo *OrganizationUpdateOne) ClearActivities() *OrganizationUpdateOne {
+	ouo.mutation.ClearActivities()
+	return ouo
+}
+
+// RemoveActivityIDs removes the "activities" edge to Activity entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveActivityIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemoveActivityIDs(ids...)
+	return ouo
+}
+
+// RemoveActivities removes "activities" edges to Activity entities.
+func (ouo *OrganizationUpdateOne) RemoveActivities(a ...*Activity) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.RemoveActivityIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (ouo *OrganizationUpdateOne) ClearWebhooks() *OrganizationUpdateOne {
+	ouo.mutation.ClearWebhooks()
+	return ouo
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveWebhookIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemoveWebhookIDs(ids...)
+	return ouo
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (ouo *OrganizationUpdateOne) RemoveWebhooks(w ...*Webhook) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.RemoveWebhookIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (ouo *OrganizationUpdateOne) ClearAPIKeys() *OrganizationUpdateOne {
+	ouo.mutation.ClearAPIKeys()
+	return ouo
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveAPIKeyIDs(ids ...uuid.UUID) *OrganizationUpdateOne {
+	ouo.mutation.RemoveAPIKeyIDs(ids...)
+	return ouo
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (ouo *OrganizationUpdateOne) RemoveAPIKeys(a ...*APIKey) *OrganizationUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ouo.RemoveAPIKeyIDs(ids...)
+}
+
 // ClearLastAccessedBy clears all "last_accessed_by" edges to the User entity.
 func (ouo *OrganizationUpdateOne) ClearLastAccessedBy() *OrganizationUpdateOne {
 	ouo.mutation.ClearLastAccessedBy()
@@ -861,9 +1358,25 @@ func (ouo *OrganizationUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Organization.slug": %w`, err)}
 		}
 	}
+	if v, ok := ouo.mutation.DefaultProjectPermission(); ok {
+		if err := organization.DefaultProjectPermissionValidator(v); err != nil {
+			return &ValidationError{Name: "default_project_permission", err: fmt.Errorf(`ent: validator failed for field "Organization.default_project_permission": %w`, err)}
+		}
+	}
+	if v, ok := ouo.mutation.MaxMembers(); ok {
+		if err := organization.MaxMembersValidator(v); err != nil {
+			return &ValidationError{Name: "max_members", err: fmt.Errorf(`ent: validator failed for field "Organization.max_members": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ouo *OrganizationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationUpdateOne {
+	ouo.modifiers = append(ouo.modifiers, modifiers...)
+	return ouo
+}
+
 func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organization, err error) {
 	if err := ouo.check(); err != nil {
 		return _node, err
@@ -899,6 +1412,24 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 	if value, ok := ouo.mutation.Slug(); ok {
 		_spec.SetField(organization.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := ouo.mutation.DefaultProjectPermission(); ok {
+		_spec.SetField(organization.FieldDefaultProjectPermission, field.TypeEnum, value)
+	}
+	if value, ok := ouo.mutation.MaxMembers(); ok {
+		_spec.SetField(organization.FieldMaxMembers, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedMaxMembers(); ok {
+		_spec.AddField(organization.FieldMaxMembers, field.TypeInt, value)
+	}
+	if ouo.mutation.MaxMembersCleared() {
+		_spec.ClearField(organization.FieldMaxMembers, field.TypeInt)
+	}
+	if value, ok := ouo.mutation.FeatureFlags(); ok {
+		_spec.SetField(organization.FieldFeatureFlags, field.TypeJSON, value)
+	}
+	if ouo.mutation.FeatureFlagsCleared() {
+		_spec.ClearField(organization.FieldFeatureFlags, field.TypeJSON)
+	}
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(organization.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1049,6 +1580,141 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedActivitiesIDs(); len(nodes) > 0 && !ouo.mutation.ActivitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ActivitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.ActivitiesTable,
+			Columns: []string{organization.ActivitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(activity.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !ouo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhooksTable,
+			Columns: []string{organization.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !ouo.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ouo.mutation.LastAccessedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1139,6 +1805,7 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(ouo.modifiers...)
 	_node = &Organization{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
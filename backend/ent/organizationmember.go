@@ -26,6 +26,8 @@ type OrganizationMember struct {
 	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
 	// Role holds the value of the "role" field.
 	Role organizationmember.Role `json:"role,omitempty"`
+	// ReadOnly holds the value of the "read_only" field.
+	ReadOnly bool `json:"read_only,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -72,6 +74,8 @@ func (*OrganizationMember) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case organizationmember.FieldReadOnly:
+			values[i] = new(sql.NullBool)
 		case organizationmember.FieldID:
 			values[i] = new(sql.NullInt64)
 		case organizationmember.FieldRole:
@@ -119,6 +123,12 @@ func (om *OrganizationMember) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				om.Role = organizationmember.Role(value.String)
 			}
+		case organizationmember.FieldReadOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_only", values[i])
+			} else if value.Valid {
+				om.ReadOnly = value.Bool
+			}
 		case organizationmember.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -180,6 +190,9 @@ func (om *OrganizationMember) String() string {
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", om.Role))
 	builder.WriteString(", ")
+	builder.WriteString("read_only=")
+	builder.WriteString(fmt.Sprintf("%v", om.ReadOnly))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(om.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
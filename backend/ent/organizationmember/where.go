@@ -66,6 +66,11 @@ func OrganizationID(v uuid.UUID) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldOrganizationID, v))
 }
 
+// ReadOnly applies equality check predicate on the "read_only" field. It's identical to ReadOnlyEQ.
+func ReadOnly(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldReadOnly, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldCreatedAt, v))
@@ -131,6 +136,16 @@ func RoleNotIn(vs ...Role) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// ReadOnlyEQ applies the EQ predicate on the "read_only" field.
+func ReadOnlyEQ(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldReadOnly, v))
+}
+
+// ReadOnlyNEQ applies the NEQ predicate on the "read_only" field.
+func ReadOnlyNEQ(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldReadOnly, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldCreatedAt, v))
@@ -49,6 +49,20 @@ func (omc *OrganizationMemberCreate) SetNillableRole(o *organizationmember.Role)
 	return omc
 }
 
+// SetReadOnly sets the "read_only" field.
+func (omc *OrganizationMemberCreate) SetReadOnly(b bool) *OrganizationMemberCreate {
+	omc.mutation.SetReadOnly(b)
+	return omc
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (omc *OrganizationMemberCreate) SetNillableReadOnly(b *bool) *OrganizationMemberCreate {
+	if b != nil {
+		omc.SetReadOnly(*b)
+	}
+	return omc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (omc *OrganizationMemberCreate) SetCreatedAt(t time.Time) *OrganizationMemberCreate {
 	omc.mutation.SetCreatedAt(t)
@@ -112,6 +126,10 @@ func (omc *OrganizationMemberCreate) defaults() {
 		v := organizationmember.DefaultRole
 		omc.mutation.SetRole(v)
 	}
+	if _, ok := omc.mutation.ReadOnly(); !ok {
+		v := organizationmember.DefaultReadOnly
+		omc.mutation.SetReadOnly(v)
+	}
 	if _, ok := omc.mutation.CreatedAt(); !ok {
 		v := organizationmember.DefaultCreatedAt()
 		omc.mutation.SetCreatedAt(v)
@@ -134,6 +152,9 @@ func (omc *OrganizationMemberCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "OrganizationMember.role": %w`, err)}
 		}
 	}
+	if _, ok := omc.mutation.ReadOnly(); !ok {
+		return &ValidationError{Name: "read_only", err: errors.New(`ent: missing required field "OrganizationMember.read_only"`)}
+	}
 	if _, ok := omc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrganizationMember.created_at"`)}
 	}
@@ -173,6 +194,10 @@ func (omc *OrganizationMemberCreate) createSpec() (*OrganizationMember, *sqlgrap
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
+	if value, ok := omc.mutation.ReadOnly(); ok {
+		_spec.SetField(organizationmember.FieldReadOnly, field.TypeBool, value)
+		_node.ReadOnly = value
+	}
 	if value, ok := omc.mutation.CreatedAt(); ok {
 		_spec.SetField(organizationmember.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -27,6 +27,7 @@ type OrganizationMemberQuery struct {
 	predicates       []predicate.OrganizationMember
 	withUser         *UserQuery
 	withOrganization *OrganizationQuery
+	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (omq *OrganizationMemberQuery) Clone() *OrganizationMemberQuery {
 		withUser:         omq.withUser.Clone(),
 		withOrganization: omq.withOrganization.Clone(),
 		// clone intermediate query.
-		sql:  omq.sql.Clone(),
-		path: omq.path,
+		sql:       omq.sql.Clone(),
+		path:      omq.path,
+		modifiers: append([]func(*sql.Selector){}, omq.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (omq *OrganizationMemberQuery) sqlAll(ctx context.Context, hooks ...queryHo
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(omq.modifiers) > 0 {
+		_spec.Modifiers = omq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (omq *OrganizationMemberQuery) loadOrganization(ctx context.Context, query
 
 func (omq *OrganizationMemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := omq.querySpec()
+	if len(omq.modifiers) > 0 {
+		_spec.Modifiers = omq.modifiers
+	}
 	_spec.Node.Columns = omq.ctx.Fields
 	if len(omq.ctx.Fields) > 0 {
 		_spec.Unique = omq.ctx.Unique != nil && *omq.ctx.Unique
@@ -574,6 +582,9 @@ func (omq *OrganizationMemberQuery) sqlQuery(ctx context.Context) *sql.Selector
 	if omq.ctx.Unique != nil && *omq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range omq.modifiers {
+		m(selector)
+	}
 	for _, p := range omq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,12 @@ func (omq *OrganizationMemberQuery) sqlQuery(ctx context.Context) *sql.Selector
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (omq *OrganizationMemberQuery) Modify(modifiers ...func(s *sql.Selector)) *OrganizationMemberSelect {
+	omq.modifiers = append(omq.modifiers, modifiers...)
+	return omq.Select()
+}
+
 // OrganizationMemberGroupBy is the group-by builder for OrganizationMember entities.
 type OrganizationMemberGroupBy struct {
 	selector
@@ -680,3 +697,9 @@ func (oms *OrganizationMemberSelect) sqlScan(ctx context.Context, root *Organiza
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (oms *OrganizationMemberSelect) Modify(modifiers ...func(s *sql.Selector)) *OrganizationMemberSelect {
+	oms.modifiers = append(oms.modifiers, modifiers...)
+	return oms
+}
@@ -20,8 +20,9 @@ import (
 // OrganizationMemberUpdate is the builder for updating OrganizationMember entities.
 type OrganizationMemberUpdate struct {
 	config
-	hooks    []Hook
-	mutation *OrganizationMemberMutation
+	hooks     []Hook
+	mutation  *OrganizationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the OrganizationMemberUpdate builder.
@@ -72,6 +73,20 @@ func (omu *OrganizationMemberUpdate) SetNillableRole(o *organizationmember.Role)
 	return omu
 }
 
+// SetReadOnly sets the "read_only" field.
+func (omu *OrganizationMemberUpdate) SetReadOnly(b bool) *OrganizationMemberUpdate {
+	omu.mutation.SetReadOnly(b)
+	return omu
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (omu *OrganizationMemberUpdate) SetNillableReadOnly(b *bool) *OrganizationMemberUpdate {
+	if b != nil {
+		omu.SetReadOnly(*b)
+	}
+	return omu
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (omu *OrganizationMemberUpdate) SetUser(u *User) *OrganizationMemberUpdate {
 	return omu.SetUserID(u.ID)
@@ -142,6 +157,12 @@ func (omu *OrganizationMemberUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (omu *OrganizationMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationMemberUpdate {
+	omu.modifiers = append(omu.modifiers, modifiers...)
+	return omu
+}
+
 func (omu *OrganizationMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := omu.check(); err != nil {
 		return n, err
@@ -157,6 +178,9 @@ func (omu *OrganizationMemberUpdate) sqlSave(ctx context.Context) (n int, err er
 	if value, ok := omu.mutation.Role(); ok {
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omu.mutation.ReadOnly(); ok {
+		_spec.SetField(organizationmember.FieldReadOnly, field.TypeBool, value)
+	}
 	if omu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -215,6 +239,7 @@ func (omu *OrganizationMemberUpdate) sqlSave(ctx context.Context) (n int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(omu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, omu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organizationmember.Label}
@@ -230,9 +255,10 @@ func (omu *OrganizationMemberUpdate) sqlSave(ctx context.Context) (n int, err er
 // OrganizationMemberUpdateOne is the builder for updating a single OrganizationMember entity.
 type OrganizationMemberUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *OrganizationMemberMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *OrganizationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -277,6 +303,20 @@ func (omuo *OrganizationMemberUpdateOne) SetNillableRole(o *organizationmember.R
 	return omuo
 }
 
+// SetReadOnly sets the "read_only" field.
+func (omuo *OrganizationMemberUpdateOne) SetReadOnly(b bool) *OrganizationMemberUpdateOne {
+	omuo.mutation.SetReadOnly(b)
+	return omuo
+}
+
+// SetNillableReadOnly sets the "read_only" field if the given value is not nil.
+func (omuo *OrganizationMemberUpdateOne) SetNillableReadOnly(b *bool) *OrganizationMemberUpdateOne {
+	if b != nil {
+		omuo.SetReadOnly(*b)
+	}
+	return omuo
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (omuo *OrganizationMemberUpdateOne) SetUser(u *User) *OrganizationMemberUpdateOne {
 	return omuo.SetUserID(u.ID)
@@ -360,6 +400,12 @@ func (omuo *OrganizationMemberUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (omuo *OrganizationMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OrganizationMemberUpdateOne {
+	omuo.modifiers = append(omuo.modifiers, modifiers...)
+	return omuo
+}
+
 func (omuo *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationMember, err error) {
 	if err := omuo.check(); err != nil {
 		return _node, err
@@ -392,6 +438,9 @@ func (omuo *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *Or
 	if value, ok := omuo.mutation.Role(); ok {
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omuo.mutation.ReadOnly(); ok {
+		_spec.SetField(organizationmember.FieldReadOnly, field.TypeBool, value)
+	}
 	if omuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -450,6 +499,7 @@ func (omuo *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *Or
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(omuo.modifiers...)
 	_node = &OrganizationMember{config: omuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -5,6 +5,7 @@ package ent
 import (
 	"backend/ent/organization"
 	"backend/ent/project"
+	"backend/ent/user"
 	"fmt"
 	"strings"
 	"time"
@@ -19,12 +20,20 @@ type Project struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// OrganizationID holds the value of the "organization_id" field.
 	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// IsPrivate holds the value of the "is_private" field.
 	IsPrivate bool `json:"is_private,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// Position holds the value of the "position" field.
+	Position *float64 `json:"position,omitempty"`
+	// CreatedByID holds the value of the "created_by_id" field.
+	CreatedByID *uuid.UUID `json:"created_by_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -43,13 +52,19 @@ type ProjectEdges struct {
 	Members []*User `json:"members,omitempty"`
 	// Invites holds the value of the invites edge.
 	Invites []*Invite `json:"invites,omitempty"`
+	// Tasks holds the value of the tasks edge.
+	Tasks []*Task `json:"tasks,omitempty"`
+	// Labels holds the value of the labels edge.
+	Labels []*Label `json:"labels,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *User `json:"created_by,omitempty"`
 	// LastAccessedBy holds the value of the last_accessed_by edge.
 	LastAccessedBy []*User `json:"last_accessed_by,omitempty"`
 	// ProjectMemberships holds the value of the project_memberships edge.
 	ProjectMemberships []*ProjectMember `json:"project_memberships,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -81,10 +96,39 @@ func (e ProjectEdges) InvitesOrErr() ([]*Invite, error) {
 	return nil, &NotLoadedError{edge: "invites"}
 }
 
+// TasksOrErr returns the Tasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[3] {
+		return e.Tasks, nil
+	}
+	return nil, &NotLoadedError{edge: "tasks"}
+}
+
+// LabelsOrErr returns the Labels value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) LabelsOrErr() ([]*Label, error) {
+	if e.loadedTypes[4] {
+		return e.Labels, nil
+	}
+	return nil, &NotLoadedError{edge: "labels"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProjectEdges) CreatedByOrErr() (*User, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
 // LastAccessedByOrErr returns the LastAccessedBy value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) LastAccessedByOrErr() ([]*User, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[6] {
 		return e.LastAccessedBy, nil
 	}
 	return nil, &NotLoadedError{edge: "last_accessed_by"}
@@ -93,7 +137,7 @@ func (e ProjectEdges) LastAccessedByOrErr() ([]*User, error) {
 // ProjectMembershipsOrErr returns the ProjectMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) ProjectMembershipsOrErr() ([]*ProjectMember, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[7] {
 		return e.ProjectMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "project_memberships"}
@@ -104,11 +148,15 @@ func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case project.FieldIsPrivate:
+		case project.FieldCreatedByID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case project.FieldIsPrivate, project.FieldIsDefault:
 			values[i] = new(sql.NullBool)
+		case project.FieldPosition:
+			values[i] = new(sql.NullFloat64)
 		case project.FieldName:
 			values[i] = new(sql.NullString)
-		case project.FieldCreatedAt, project.FieldUpdatedAt:
+		case project.FieldDeletedAt, project.FieldCreatedAt, project.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case project.FieldID, project.FieldOrganizationID:
 			values[i] = new(uuid.UUID)
@@ -133,6 +181,13 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pr.ID = *value
 			}
+		case project.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
 		case project.FieldOrganizationID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
@@ -151,6 +206,26 @@ func (pr *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.IsPrivate = value.Bool
 			}
+		case project.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				pr.IsDefault = value.Bool
+			}
+		case project.FieldPosition:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pr.Position = new(float64)
+				*pr.Position = value.Float64
+			}
+		case project.FieldCreatedByID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_id", values[i])
+			} else if value.Valid {
+				pr.CreatedByID = new(uuid.UUID)
+				*pr.CreatedByID = *value.S.(*uuid.UUID)
+			}
 		case project.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -191,6 +266,21 @@ func (pr *Project) QueryInvites() *InviteQuery {
 	return NewProjectClient(pr.config).QueryInvites(pr)
 }
 
+// QueryTasks queries the "tasks" edge of the Project entity.
+func (pr *Project) QueryTasks() *TaskQuery {
+	return NewProjectClient(pr.config).QueryTasks(pr)
+}
+
+// QueryLabels queries the "labels" edge of the Project entity.
+func (pr *Project) QueryLabels() *LabelQuery {
+	return NewProjectClient(pr.config).QueryLabels(pr)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the Project entity.
+func (pr *Project) QueryCreatedBy() *UserQuery {
+	return NewProjectClient(pr.config).QueryCreatedBy(pr)
+}
+
 // QueryLastAccessedBy queries the "last_accessed_by" edge of the Project entity.
 func (pr *Project) QueryLastAccessedBy() *UserQuery {
 	return NewProjectClient(pr.config).QueryLastAccessedBy(pr)
@@ -224,6 +314,11 @@ func (pr *Project) String() string {
 	var builder strings.Builder
 	builder.WriteString("Project(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("organization_id=")
 	builder.WriteString(fmt.Sprintf("%v", pr.OrganizationID))
 	builder.WriteString(", ")
@@ -233,6 +328,19 @@ func (pr *Project) String() string {
 	builder.WriteString("is_private=")
 	builder.WriteString(fmt.Sprintf("%v", pr.IsPrivate))
 	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", pr.IsDefault))
+	builder.WriteString(", ")
+	if v := pr.Position; v != nil {
+		builder.WriteString("position=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := pr.CreatedByID; v != nil {
+		builder.WriteString("created_by_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
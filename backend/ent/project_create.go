@@ -4,9 +4,11 @@ package ent
 
 import (
 	"backend/ent/invite"
+	"backend/ent/label"
 	"backend/ent/organization"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/task"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -25,6 +27,20 @@ type ProjectCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProjectCreate) SetDeletedAt(t time.Time) *ProjectCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableDeletedAt(t *time.Time) *ProjectCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
 // SetOrganizationID sets the "organization_id" field.
 func (pc *ProjectCreate) SetOrganizationID(u uuid.UUID) *ProjectCreate {
 	pc.mutation.SetOrganizationID(u)
@@ -51,6 +67,48 @@ func (pc *ProjectCreate) SetNillableIsPrivate(b *bool) *ProjectCreate {
 	return pc
 }
 
+// SetIsDefault sets the "is_default" field.
+func (pc *ProjectCreate) SetIsDefault(b bool) *ProjectCreate {
+	pc.mutation.SetIsDefault(b)
+	return pc
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableIsDefault(b *bool) *ProjectCreate {
+	if b != nil {
+		pc.SetIsDefault(*b)
+	}
+	return pc
+}
+
+// SetPosition sets the "position" field.
+func (pc *ProjectCreate) SetPosition(f float64) *ProjectCreate {
+	pc.mutation.SetPosition(f)
+	return pc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillablePosition(f *float64) *ProjectCreate {
+	if f != nil {
+		pc.SetPosition(*f)
+	}
+	return pc
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (pc *ProjectCreate) SetCreatedByID(u uuid.UUID) *ProjectCreate {
+	pc.mutation.SetCreatedByID(u)
+	return pc
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (pc *ProjectCreate) SetNillableCreatedByID(u *uuid.UUID) *ProjectCreate {
+	if u != nil {
+		pc.SetCreatedByID(*u)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProjectCreate) SetCreatedAt(t time.Time) *ProjectCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -128,6 +186,41 @@ func (pc *ProjectCreate) AddInvites(i ...*Invite) *ProjectCreate {
 	return pc.AddInviteIDs(ids...)
 }
 
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (pc *ProjectCreate) AddTaskIDs(ids ...uuid.UUID) *ProjectCreate {
+	pc.mutation.AddTaskIDs(ids...)
+	return pc
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (pc *ProjectCreate) AddTasks(t ...*Task) *ProjectCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (pc *ProjectCreate) AddLabelIDs(ids ...uuid.UUID) *ProjectCreate {
+	pc.mutation.AddLabelIDs(ids...)
+	return pc
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (pc *ProjectCreate) AddLabels(l ...*Label) *ProjectCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pc.AddLabelIDs(ids...)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (pc *ProjectCreate) SetCreatedBy(u *User) *ProjectCreate {
+	return pc.SetCreatedByID(u.ID)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (pc *ProjectCreate) AddLastAccessedByIDs(ids ...uuid.UUID) *ProjectCreate {
 	pc.mutation.AddLastAccessedByIDs(ids...)
@@ -197,6 +290,10 @@ func (pc *ProjectCreate) defaults() {
 		v := project.DefaultIsPrivate
 		pc.mutation.SetIsPrivate(v)
 	}
+	if _, ok := pc.mutation.IsDefault(); !ok {
+		v := project.DefaultIsDefault
+		pc.mutation.SetIsDefault(v)
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		v := project.DefaultCreatedAt()
 		pc.mutation.SetCreatedAt(v)
@@ -227,6 +324,9 @@ func (pc *ProjectCreate) check() error {
 	if _, ok := pc.mutation.IsPrivate(); !ok {
 		return &ValidationError{Name: "is_private", err: errors.New(`ent: missing required field "Project.is_private"`)}
 	}
+	if _, ok := pc.mutation.IsDefault(); !ok {
+		return &ValidationError{Name: "is_default", err: errors.New(`ent: missing required field "Project.is_default"`)}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Project.created_at"`)}
 	}
@@ -271,6 +371,10 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(project.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -279,6 +383,14 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldIsPrivate, field.TypeBool, value)
 		_node.IsPrivate = value
 	}
+	if value, ok := pc.mutation.IsDefault(); ok {
+		_spec.SetField(project.FieldIsDefault, field.TypeBool, value)
+		_node.IsDefault = value
+	}
+	if value, ok := pc.mutation.Position(); ok {
+		_spec.SetField(project.FieldPosition, field.TypeFloat64, value)
+		_node.Position = &value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(project.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -340,6 +452,55 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   project.CreatedByTable,
+			Columns: []string{project.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.LastAccessedByIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
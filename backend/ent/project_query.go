@@ -4,10 +4,12 @@ package ent
 
 import (
 	"backend/ent/invite"
+	"backend/ent/label"
 	"backend/ent/organization"
 	"backend/ent/predicate"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/task"
 	"backend/ent/user"
 	"context"
 	"database/sql/driver"
@@ -31,8 +33,12 @@ type ProjectQuery struct {
 	withOrganization       *OrganizationQuery
 	withMembers            *UserQuery
 	withInvites            *InviteQuery
+	withTasks              *TaskQuery
+	withLabels             *LabelQuery
+	withCreatedBy          *UserQuery
 	withLastAccessedBy     *UserQuery
 	withProjectMemberships *ProjectMemberQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -135,6 +141,72 @@ func (pq *ProjectQuery) QueryInvites() *InviteQuery {
 	return query
 }
 
+// QueryTasks chains the current query on the "tasks" edge.
+func (pq *ProjectQuery) QueryTasks() *TaskQuery {
+	query := (&TaskClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TasksTable, project.TasksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLabels chains the current query on the "labels" edge.
+func (pq *ProjectQuery) QueryLabels() *LabelQuery {
+	query := (&LabelClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(label.Table, label.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.LabelsTable, project.LabelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCreatedBy chains the current query on the "created_by" edge.
+func (pq *ProjectQuery) QueryCreatedBy() *UserQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, project.CreatedByTable, project.CreatedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryLastAccessedBy chains the current query on the "last_accessed_by" edge.
 func (pq *ProjectQuery) QueryLastAccessedBy() *UserQuery {
 	query := (&UserClient{config: pq.config}).Query()
@@ -374,11 +446,15 @@ func (pq *ProjectQuery) Clone() *ProjectQuery {
 		withOrganization:       pq.withOrganization.Clone(),
 		withMembers:            pq.withMembers.Clone(),
 		withInvites:            pq.withInvites.Clone(),
+		withTasks:              pq.withTasks.Clone(),
+		withLabels:             pq.withLabels.Clone(),
+		withCreatedBy:          pq.withCreatedBy.Clone(),
 		withLastAccessedBy:     pq.withLastAccessedBy.Clone(),
 		withProjectMemberships: pq.withProjectMemberships.Clone(),
 		// clone intermediate query.
-		sql:  pq.sql.Clone(),
-		path: pq.path,
+		sql:       pq.sql.Clone(),
+		path:      pq.path,
+		modifiers: append([]func(*sql.Selector){}, pq.modifiers...),
 	}
 }
 
@@ -415,6 +491,39 @@ func (pq *ProjectQuery) WithInvites(opts ...func(*InviteQuery)) *ProjectQuery {
 	return pq
 }
 
+// WithTasks tells the query-builder to eager-load the nodes that are connected to
+// the "tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithTasks(opts ...func(*TaskQuery)) *ProjectQuery {
+	query := (&TaskClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTasks = query
+	return pq
+}
+
+// WithLabels tells the query-builder to eager-load the nodes that are connected to
+// the "labels" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithLabels(opts ...func(*LabelQuery)) *ProjectQuery {
+	query := (&LabelClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withLabels = query
+	return pq
+}
+
+// WithCreatedBy tells the query-builder to eager-load the nodes that are connected to
+// the "created_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithCreatedBy(opts ...func(*UserQuery)) *ProjectQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCreatedBy = query
+	return pq
+}
+
 // WithLastAccessedBy tells the query-builder to eager-load the nodes that are connected to
 // the "last_accessed_by" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProjectQuery) WithLastAccessedBy(opts ...func(*UserQuery)) *ProjectQuery {
@@ -443,12 +552,12 @@ func (pq *ProjectQuery) WithProjectMemberships(opts ...func(*ProjectMemberQuery)
 // Example:
 //
 //	var v []struct {
-//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Project.Query().
-//		GroupBy(project.FieldOrganizationID).
+//		GroupBy(project.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (pq *ProjectQuery) GroupBy(field string, fields ...string) *ProjectGroupBy {
@@ -466,11 +575,11 @@ func (pq *ProjectQuery) GroupBy(field string, fields ...string) *ProjectGroupBy
 // Example:
 //
 //	var v []struct {
-//		OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Project.Query().
-//		Select(project.FieldOrganizationID).
+//		Select(project.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (pq *ProjectQuery) Select(fields ...string) *ProjectSelect {
 	pq.ctx.Fields = append(pq.ctx.Fields, fields...)
@@ -515,10 +624,13 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = pq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			pq.withOrganization != nil,
 			pq.withMembers != nil,
 			pq.withInvites != nil,
+			pq.withTasks != nil,
+			pq.withLabels != nil,
+			pq.withCreatedBy != nil,
 			pq.withLastAccessedBy != nil,
 			pq.withProjectMemberships != nil,
 		}
@@ -532,6 +644,9 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -561,6 +676,26 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := pq.withTasks; query != nil {
+		if err := pq.loadTasks(ctx, query, nodes,
+			func(n *Project) { n.Edges.Tasks = []*Task{} },
+			func(n *Project, e *Task) { n.Edges.Tasks = append(n.Edges.Tasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withLabels; query != nil {
+		if err := pq.loadLabels(ctx, query, nodes,
+			func(n *Project) { n.Edges.Labels = []*Label{} },
+			func(n *Project, e *Label) { n.Edges.Labels = append(n.Edges.Labels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withCreatedBy; query != nil {
+		if err := pq.loadCreatedBy(ctx, query, nodes, nil,
+			func(n *Project, e *User) { n.Edges.CreatedBy = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withLastAccessedBy; query != nil {
 		if err := pq.loadLastAccessedBy(ctx, query, nodes,
 			func(n *Project) { n.Edges.LastAccessedBy = []*User{} },
@@ -701,6 +836,98 @@ func (pq *ProjectQuery) loadInvites(ctx context.Context, query *InviteQuery, nod
 	}
 	return nil
 }
+func (pq *ProjectQuery) loadTasks(ctx context.Context, query *TaskQuery, nodes []*Project, init func(*Project), assign func(*Project, *Task)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(task.FieldProjectID)
+	}
+	query.Where(predicate.Task(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.TasksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadLabels(ctx context.Context, query *LabelQuery, nodes []*Project, init func(*Project), assign func(*Project, *Label)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(label.FieldProjectID)
+	}
+	query.Where(predicate.Label(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.LabelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadCreatedBy(ctx context.Context, query *UserQuery, nodes []*Project, init func(*Project), assign func(*Project, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Project)
+	for i := range nodes {
+		if nodes[i].CreatedByID == nil {
+			continue
+		}
+		fk := *nodes[i].CreatedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "created_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (pq *ProjectQuery) loadLastAccessedBy(ctx context.Context, query *UserQuery, nodes []*Project, init func(*Project), assign func(*Project, *User)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Project)
@@ -767,6 +994,9 @@ func (pq *ProjectQuery) loadProjectMemberships(ctx context.Context, query *Proje
 
 func (pq *ProjectQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
+	if len(pq.modifiers) > 0 {
+		_spec.Modifiers = pq.modifiers
+	}
 	_spec.Node.Columns = pq.ctx.Fields
 	if len(pq.ctx.Fields) > 0 {
 		_spec.Unique = pq.ctx.Unique != nil && *pq.ctx.Unique
@@ -793,6 +1023,9 @@ func (pq *ProjectQuery) querySpec() *sqlgraph.QuerySpec {
 		if pq.withOrganization != nil {
 			_spec.Node.AddColumnOnce(project.FieldOrganizationID)
 		}
+		if pq.withCreatedBy != nil {
+			_spec.Node.AddColumnOnce(project.FieldCreatedByID)
+		}
 	}
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -832,6 +1065,9 @@ func (pq *ProjectQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pq.ctx.Unique != nil && *pq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pq.modifiers {
+		m(selector)
+	}
 	for _, p := range pq.predicates {
 		p(selector)
 	}
@@ -849,6 +1085,12 @@ func (pq *ProjectQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pq *ProjectQuery) Modify(modifiers ...func(s *sql.Selector)) *ProjectSelect {
+	pq.modifiers = append(pq.modifiers, modifiers...)
+	return pq.Select()
+}
+
 // ProjectGroupBy is the group-by builder for Project entities.
 type ProjectGroupBy struct {
 	selector
@@ -938,3 +1180,9 @@ func (ps *ProjectSelect) sqlScan(ctx context.Context, root *ProjectQuery, v any)
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ps *ProjectSelect) Modify(modifiers ...func(s *sql.Selector)) *ProjectSelect {
+	ps.modifiers = append(ps.modifiers, modifiers...)
+	return ps
+}
@@ -4,10 +4,12 @@ package ent
 
 import (
 	"backend/ent/invite"
+	"backend/ent/label"
 	"backend/ent/organization"
 	"backend/ent/predicate"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/task"
 	"backend/ent/user"
 	"context"
 	"errors"
@@ -23,8 +25,9 @@ import (
 // ProjectUpdate is the builder for updating Project entities.
 type ProjectUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ProjectMutation
+	hooks     []Hook
+	mutation  *ProjectMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ProjectUpdate builder.
@@ -33,6 +36,26 @@ func (pu *ProjectUpdate) Where(ps ...predicate.Project) *ProjectUpdate {
 	return pu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProjectUpdate) SetDeletedAt(t time.Time) *ProjectUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableDeletedAt(t *time.Time) *ProjectUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProjectUpdate) ClearDeletedAt() *ProjectUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
 // SetOrganizationID sets the "organization_id" field.
 func (pu *ProjectUpdate) SetOrganizationID(u uuid.UUID) *ProjectUpdate {
 	pu.mutation.SetOrganizationID(u)
@@ -75,6 +98,67 @@ func (pu *ProjectUpdate) SetNillableIsPrivate(b *bool) *ProjectUpdate {
 	return pu
 }
 
+// SetIsDefault sets the "is_default" field.
+func (pu *ProjectUpdate) SetIsDefault(b bool) *ProjectUpdate {
+	pu.mutation.SetIsDefault(b)
+	return pu
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableIsDefault(b *bool) *ProjectUpdate {
+	if b != nil {
+		pu.SetIsDefault(*b)
+	}
+	return pu
+}
+
+// SetPosition sets the "position" field.
+func (pu *ProjectUpdate) SetPosition(f float64) *ProjectUpdate {
+	pu.mutation.ResetPosition()
+	pu.mutation.SetPosition(f)
+	return pu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillablePosition(f *float64) *ProjectUpdate {
+	if f != nil {
+		pu.SetPosition(*f)
+	}
+	return pu
+}
+
+// AddPosition adds f to the "position" field.
+func (pu *ProjectUpdate) AddPosition(f float64) *ProjectUpdate {
+	pu.mutation.AddPosition(f)
+	return pu
+}
+
+// ClearPosition clears the value of the "position" field.
+func (pu *ProjectUpdate) ClearPosition() *ProjectUpdate {
+	pu.mutation.ClearPosition()
+	return pu
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (pu *ProjectUpdate) SetCreatedByID(u uuid.UUID) *ProjectUpdate {
+	pu.mutation.SetCreatedByID(u)
+	return pu
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (pu *ProjectUpdate) SetNillableCreatedByID(u *uuid.UUID) *ProjectUpdate {
+	if u != nil {
+		pu.SetCreatedByID(*u)
+	}
+	return pu
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (pu *ProjectUpdate) ClearCreatedByID() *ProjectUpdate {
+	pu.mutation.ClearCreatedByID()
+	return pu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (pu *ProjectUpdate) SetUpdatedAt(t time.Time) *ProjectUpdate {
 	pu.mutation.SetUpdatedAt(t)
@@ -116,6 +200,41 @@ func (pu *ProjectUpdate) AddInvites(i ...*Invite) *ProjectUpdate {
 	return pu.AddInviteIDs(ids...)
 }
 
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (pu *ProjectUpdate) AddTaskIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.AddTaskIDs(ids...)
+	return pu
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (pu *ProjectUpdate) AddTasks(t ...*Task) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (pu *ProjectUpdate) AddLabelIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.AddLabelIDs(ids...)
+	return pu
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (pu *ProjectUpdate) AddLabels(l ...*Label) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.AddLabelIDs(ids...)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (pu *ProjectUpdate) SetCreatedBy(u *User) *ProjectUpdate {
+	return pu.SetCreatedByID(u.ID)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (pu *ProjectUpdate) AddLastAccessedByIDs(ids ...uuid.UUID) *ProjectUpdate {
 	pu.mutation.AddLastAccessedByIDs(ids...)
@@ -199,6 +318,54 @@ func (pu *ProjectUpdate) RemoveInvites(i ...*Invite) *ProjectUpdate {
 	return pu.RemoveInviteIDs(ids...)
 }
 
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (pu *ProjectUpdate) ClearTasks() *ProjectUpdate {
+	pu.mutation.ClearTasks()
+	return pu
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (pu *ProjectUpdate) RemoveTaskIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.RemoveTaskIDs(ids...)
+	return pu
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (pu *ProjectUpdate) RemoveTasks(t ...*Task) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTaskIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (pu *ProjectUpdate) ClearLabels() *ProjectUpdate {
+	pu.mutation.ClearLabels()
+	return pu
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (pu *ProjectUpdate) RemoveLabelIDs(ids ...uuid.UUID) *ProjectUpdate {
+	pu.mutation.RemoveLabelIDs(ids...)
+	return pu
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (pu *ProjectUpdate) RemoveLabels(l ...*Label) *ProjectUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return pu.RemoveLabelIDs(ids...)
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (pu *ProjectUpdate) ClearCreatedBy() *ProjectUpdate {
+	pu.mutation.ClearCreatedBy()
+	return pu
+}
+
 // ClearLastAccessedBy clears all "last_accessed_by" edges to the User entity.
 func (pu *ProjectUpdate) ClearLastAccessedBy() *ProjectUpdate {
 	pu.mutation.ClearLastAccessedBy()
@@ -290,6 +457,12 @@ func (pu *ProjectUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pu *ProjectUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectUpdate {
+	pu.modifiers = append(pu.modifiers, modifiers...)
+	return pu
+}
+
 func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := pu.check(); err != nil {
 		return n, err
@@ -302,12 +475,30 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(project.FieldName, field.TypeString, value)
 	}
 	if value, ok := pu.mutation.IsPrivate(); ok {
 		_spec.SetField(project.FieldIsPrivate, field.TypeBool, value)
 	}
+	if value, ok := pu.mutation.IsDefault(); ok {
+		_spec.SetField(project.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.Position(); ok {
+		_spec.SetField(project.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := pu.mutation.AddedPosition(); ok {
+		_spec.AddField(project.FieldPosition, field.TypeFloat64, value)
+	}
+	if pu.mutation.PositionCleared() {
+		_spec.ClearField(project.FieldPosition, field.TypeFloat64)
+	}
 	if value, ok := pu.mutation.UpdatedAt(); ok {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -442,6 +633,125 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTasksIDs(); len(nodes) > 0 && !pu.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !pu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   project.CreatedByTable,
+			Columns: []string{project.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   project.CreatedByTable,
+			Columns: []string{project.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pu.mutation.LastAccessedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -532,6 +842,7 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{project.Label}
@@ -547,9 +858,30 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ProjectUpdateOne is the builder for updating a single Project entity.
 type ProjectUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProjectMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ProjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProjectUpdateOne) SetDeletedAt(t time.Time) *ProjectUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableDeletedAt(t *time.Time) *ProjectUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProjectUpdateOne) ClearDeletedAt() *ProjectUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
 }
 
 // SetOrganizationID sets the "organization_id" field.
@@ -594,6 +926,67 @@ func (puo *ProjectUpdateOne) SetNillableIsPrivate(b *bool) *ProjectUpdateOne {
 	return puo
 }
 
+// SetIsDefault sets the "is_default" field.
+func (puo *ProjectUpdateOne) SetIsDefault(b bool) *ProjectUpdateOne {
+	puo.mutation.SetIsDefault(b)
+	return puo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableIsDefault(b *bool) *ProjectUpdateOne {
+	if b != nil {
+		puo.SetIsDefault(*b)
+	}
+	return puo
+}
+
+// SetPosition sets the "position" field.
+func (puo *ProjectUpdateOne) SetPosition(f float64) *ProjectUpdateOne {
+	puo.mutation.ResetPosition()
+	puo.mutation.SetPosition(f)
+	return puo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillablePosition(f *float64) *ProjectUpdateOne {
+	if f != nil {
+		puo.SetPosition(*f)
+	}
+	return puo
+}
+
+// AddPosition adds f to the "position" field.
+func (puo *ProjectUpdateOne) AddPosition(f float64) *ProjectUpdateOne {
+	puo.mutation.AddPosition(f)
+	return puo
+}
+
+// ClearPosition clears the value of the "position" field.
+func (puo *ProjectUpdateOne) ClearPosition() *ProjectUpdateOne {
+	puo.mutation.ClearPosition()
+	return puo
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (puo *ProjectUpdateOne) SetCreatedByID(u uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.SetCreatedByID(u)
+	return puo
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (puo *ProjectUpdateOne) SetNillableCreatedByID(u *uuid.UUID) *ProjectUpdateOne {
+	if u != nil {
+		puo.SetCreatedByID(*u)
+	}
+	return puo
+}
+
+// ClearCreatedByID clears the value of the "created_by_id" field.
+func (puo *ProjectUpdateOne) ClearCreatedByID() *ProjectUpdateOne {
+	puo.mutation.ClearCreatedByID()
+	return puo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (puo *ProjectUpdateOne) SetUpdatedAt(t time.Time) *ProjectUpdateOne {
 	puo.mutation.SetUpdatedAt(t)
@@ -635,6 +1028,41 @@ func (puo *ProjectUpdateOne) AddInvites(i ...*Invite) *ProjectUpdateOne {
 	return puo.AddInviteIDs(ids...)
 }
 
+// AddTaskIDs adds the "tasks" edge to the Task entity by IDs.
+func (puo *ProjectUpdateOne) AddTaskIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.AddTaskIDs(ids...)
+	return puo
+}
+
+// AddTasks adds the "tasks" edges to the Task entity.
+func (puo *ProjectUpdateOne) AddTasks(t ...*Task) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTaskIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (puo *ProjectUpdateOne) AddLabelIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.AddLabelIDs(ids...)
+	return puo
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (puo *ProjectUpdateOne) AddLabels(l ...*Label) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.AddLabelIDs(ids...)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (puo *ProjectUpdateOne) SetCreatedBy(u *User) *ProjectUpdateOne {
+	return puo.SetCreatedByID(u.ID)
+}
+
 // AddLastAccessedByIDs adds the "last_accessed_by" edge to the User entity by IDs.
 func (puo *ProjectUpdateOne) AddLastAccessedByIDs(ids ...uuid.UUID) *ProjectUpdateOne {
 	puo.mutation.AddLastAccessedByIDs(ids...)
@@ -718,6 +1146,54 @@ func (puo *ProjectUpdateOne) RemoveInvites(i ...*Invite) *ProjectUpdateOne {
 	return puo.RemoveInviteIDs(ids...)
 }
 
+// ClearTasks clears all "tasks" edges to the Task entity.
+func (puo *ProjectUpdateOne) ClearTasks() *ProjectUpdateOne {
+	puo.mutation.ClearTasks()
+	return puo
+}
+
+// RemoveTaskIDs removes the "tasks" edge to Task entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTaskIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.RemoveTaskIDs(ids...)
+	return puo
+}
+
+// RemoveTasks removes "tasks" edges to Task entities.
+func (puo *ProjectUpdateOne) RemoveTasks(t ...*Task) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.RemoveTaskIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (puo *ProjectUpdateOne) ClearLabels() *ProjectUpdateOne {
+	puo.mutation.ClearLabels()
+	return puo
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (puo *ProjectUpdateOne) RemoveLabelIDs(ids ...uuid.UUID) *ProjectUpdateOne {
+	puo.mutation.RemoveLabelIDs(ids...)
+	return puo
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (puo *ProjectUpdateOne) RemoveLabels(l ...*Label) *ProjectUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return puo.RemoveLabelIDs(ids...)
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (puo *ProjectUpdateOne) ClearCreatedBy() *ProjectUpdateOne {
+	puo.mutation.ClearCreatedBy()
+	return puo
+}
+
 // ClearLastAccessedBy clears all "last_accessed_by" edges to the User entity.
 func (puo *ProjectUpdateOne) ClearLastAccessedBy() *ProjectUpdateOne {
 	puo.mutation.ClearLastAccessedBy()
@@ -822,6 +1298,12 @@ func (puo *ProjectUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (puo *ProjectUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectUpdateOne {
+	puo.modifiers = append(puo.modifiers, modifiers...)
+	return puo
+}
+
 func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err error) {
 	if err := puo.check(); err != nil {
 		return _node, err
@@ -851,12 +1333,30 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 			}
 		}
 	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(project.FieldName, field.TypeString, value)
 	}
 	if value, ok := puo.mutation.IsPrivate(); ok {
 		_spec.SetField(project.FieldIsPrivate, field.TypeBool, value)
 	}
+	if value, ok := puo.mutation.IsDefault(); ok {
+		_spec.SetField(project.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.Position(); ok {
+		_spec.SetField(project.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := puo.mutation.AddedPosition(); ok {
+		_spec.AddField(project.FieldPosition, field.TypeFloat64, value)
+	}
+	if puo.mutation.PositionCleared() {
+		_spec.ClearField(project.FieldPosition, field.TypeFloat64)
+	}
 	if value, ok := puo.mutation.UpdatedAt(); ok {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -991,6 +1491,125 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTasksIDs(); len(nodes) > 0 && !puo.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TasksTable,
+			Columns: []string{project.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !puo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.LabelsTable,
+			Columns: []string{project.LabelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   project.CreatedByTable,
+			Columns: []string{project.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   project.CreatedByTable,
+			Columns: []string{project.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.LastAccessedByCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1081,6 +1700,7 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(puo.modifiers...)
 	_node = &Project{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -27,6 +27,7 @@ type ProjectMemberQuery struct {
 	predicates  []predicate.ProjectMember
 	withUser    *UserQuery
 	withProject *ProjectQuery
+	modifiers   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -302,8 +303,9 @@ func (pmq *ProjectMemberQuery) Clone() *ProjectMemberQuery {
 		withUser:    pmq.withUser.Clone(),
 		withProject: pmq.withProject.Clone(),
 		// clone intermediate query.
-		sql:  pmq.sql.Clone(),
-		path: pmq.path,
+		sql:       pmq.sql.Clone(),
+		path:      pmq.path,
+		modifiers: append([]func(*sql.Selector){}, pmq.modifiers...),
 	}
 }
 
@@ -421,6 +423,9 @@ func (pmq *ProjectMemberQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -506,6 +511,9 @@ func (pmq *ProjectMemberQuery) loadProject(ctx context.Context, query *ProjectQu
 
 func (pmq *ProjectMemberQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
 	_spec.Node.Columns = pmq.ctx.Fields
 	if len(pmq.ctx.Fields) > 0 {
 		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
@@ -574,6 +582,9 @@ func (pmq *ProjectMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
 	for _, p := range pmq.predicates {
 		p(selector)
 	}
@@ -591,6 +602,12 @@ func (pmq *ProjectMemberQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pmq *ProjectMemberQuery) Modify(modifiers ...func(s *sql.Selector)) *ProjectMemberSelect {
+	pmq.modifiers = append(pmq.modifiers, modifiers...)
+	return pmq.Select()
+}
+
 // ProjectMemberGroupBy is the group-by builder for ProjectMember entities.
 type ProjectMemberGroupBy struct {
 	selector
@@ -680,3 +697,9 @@ func (pms *ProjectMemberSelect) sqlScan(ctx context.Context, root *ProjectMember
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pms *ProjectMemberSelect) Modify(modifiers ...func(s *sql.Selector)) *ProjectMemberSelect {
+	pms.modifiers = append(pms.modifiers, modifiers...)
+	return pms
+}
@@ -20,8 +20,9 @@ import (
 // ProjectMemberUpdate is the builder for updating ProjectMember entities.
 type ProjectMemberUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ProjectMemberMutation
+	hooks     []Hook
+	mutation  *ProjectMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ProjectMemberUpdate builder.
@@ -142,6 +143,12 @@ func (pmu *ProjectMemberUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmu *ProjectMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectMemberUpdate {
+	pmu.modifiers = append(pmu.modifiers, modifiers...)
+	return pmu
+}
+
 func (pmu *ProjectMemberUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := pmu.check(); err != nil {
 		return n, err
@@ -215,6 +222,7 @@ func (pmu *ProjectMemberUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(pmu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{projectmember.Label}
@@ -230,9 +238,10 @@ func (pmu *ProjectMemberUpdate) sqlSave(ctx context.Context) (n int, err error)
 // ProjectMemberUpdateOne is the builder for updating a single ProjectMember entity.
 type ProjectMemberUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ProjectMemberMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ProjectMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -360,6 +369,12 @@ func (pmuo *ProjectMemberUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmuo *ProjectMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ProjectMemberUpdateOne {
+	pmuo.modifiers = append(pmuo.modifiers, modifiers...)
+	return pmuo
+}
+
 func (pmuo *ProjectMemberUpdateOne) sqlSave(ctx context.Context) (_node *ProjectMember, err error) {
 	if err := pmuo.check(); err != nil {
 		return _node, err
@@ -450,6 +465,7 @@ func (pmuo *ProjectMemberUpdateOne) sqlSave(ctx context.Context) (_node *Project
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(pmuo.modifiers...)
 	_node = &ProjectMember{config: pmuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
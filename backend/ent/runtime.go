@@ -2,141 +2,4 @@
 
 package ent
 
-import (
-	"backend/ent/invite"
-	"backend/ent/organization"
-	"backend/ent/organizationmember"
-	"backend/ent/project"
-	"backend/ent/projectmember"
-	"backend/ent/schema"
-	"backend/ent/user"
-	"time"
-
-	"github.com/google/uuid"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	inviteFields := schema.Invite{}.Fields()
-	_ = inviteFields
-	// inviteDescToken is the schema descriptor for token field.
-	inviteDescToken := inviteFields[1].Descriptor()
-	// invite.TokenValidator is a validator for the "token" field. It is called by the builders before save.
-	invite.TokenValidator = inviteDescToken.Validators[0].(func(string) error)
-	// inviteDescEmail is the schema descriptor for email field.
-	inviteDescEmail := inviteFields[2].Descriptor()
-	// invite.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	invite.EmailValidator = inviteDescEmail.Validators[0].(func(string) error)
-	// inviteDescCreatedAt is the schema descriptor for created_at field.
-	inviteDescCreatedAt := inviteFields[10].Descriptor()
-	// invite.DefaultCreatedAt holds the default value on creation for the created_at field.
-	invite.DefaultCreatedAt = inviteDescCreatedAt.Default.(func() time.Time)
-	// inviteDescID is the schema descriptor for id field.
-	inviteDescID := inviteFields[0].Descriptor()
-	// invite.DefaultID holds the default value on creation for the id field.
-	invite.DefaultID = inviteDescID.Default.(func() uuid.UUID)
-	organizationFields := schema.Organization{}.Fields()
-	_ = organizationFields
-	// organizationDescName is the schema descriptor for name field.
-	organizationDescName := organizationFields[1].Descriptor()
-	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
-	// organizationDescSlug is the schema descriptor for slug field.
-	organizationDescSlug := organizationFields[2].Descriptor()
-	// organization.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
-	organization.SlugValidator = func() func(string) error {
-		validators := organizationDescSlug.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(slug string) error {
-			for _, fn := range fns {
-				if err := fn(slug); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
-	// organizationDescCreatedAt is the schema descriptor for created_at field.
-	organizationDescCreatedAt := organizationFields[3].Descriptor()
-	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
-	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
-	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
-	organizationDescUpdatedAt := organizationFields[4].Descriptor()
-	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
-	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// organizationDescID is the schema descriptor for id field.
-	organizationDescID := organizationFields[0].Descriptor()
-	// organization.DefaultID holds the default value on creation for the id field.
-	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
-	organizationmemberFields := schema.OrganizationMember{}.Fields()
-	_ = organizationmemberFields
-	// organizationmemberDescCreatedAt is the schema descriptor for created_at field.
-	organizationmemberDescCreatedAt := organizationmemberFields[3].Descriptor()
-	// organizationmember.DefaultCreatedAt holds the default value on creation for the created_at field.
-	organizationmember.DefaultCreatedAt = organizationmemberDescCreatedAt.Default.(func() time.Time)
-	projectFields := schema.Project{}.Fields()
-	_ = projectFields
-	// projectDescName is the schema descriptor for name field.
-	projectDescName := projectFields[2].Descriptor()
-	// project.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	project.NameValidator = projectDescName.Validators[0].(func(string) error)
-	// projectDescIsPrivate is the schema descriptor for is_private field.
-	projectDescIsPrivate := projectFields[3].Descriptor()
-	// project.DefaultIsPrivate holds the default value on creation for the is_private field.
-	project.DefaultIsPrivate = projectDescIsPrivate.Default.(bool)
-	// projectDescCreatedAt is the schema descriptor for created_at field.
-	projectDescCreatedAt := projectFields[4].Descriptor()
-	// project.DefaultCreatedAt holds the default value on creation for the created_at field.
-	project.DefaultCreatedAt = projectDescCreatedAt.Default.(func() time.Time)
-	// projectDescUpdatedAt is the schema descriptor for updated_at field.
-	projectDescUpdatedAt := projectFields[5].Descriptor()
-	// project.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
-	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// projectDescID is the schema descriptor for id field.
-	projectDescID := projectFields[0].Descriptor()
-	// project.DefaultID holds the default value on creation for the id field.
-	project.DefaultID = projectDescID.Default.(func() uuid.UUID)
-	projectmemberFields := schema.ProjectMember{}.Fields()
-	_ = projectmemberFields
-	// projectmemberDescCreatedAt is the schema descriptor for created_at field.
-	projectmemberDescCreatedAt := projectmemberFields[3].Descriptor()
-	// projectmember.DefaultCreatedAt holds the default value on creation for the created_at field.
-	projectmember.DefaultCreatedAt = projectmemberDescCreatedAt.Default.(func() time.Time)
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[1].Descriptor()
-	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
-	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[2].Descriptor()
-	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
-	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
-	// userDescDisplayName is the schema descriptor for display_name field.
-	userDescDisplayName := userFields[3].Descriptor()
-	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
-	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
-	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
-	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
-	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
-	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
-	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
-	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in backend/ent/runtime/runtime.go
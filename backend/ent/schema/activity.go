@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Activity holds the schema definition for the Activity entity.
+type Activity struct {
+	ent.Schema
+}
+
+// Fields of the Activity.
+func (Activity) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).
+			Immutable(),
+		// What happened, e.g. "task.created"
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		// Kind of entity the action was performed on, e.g. "task"
+		field.String("target_type").
+			NotEmpty().
+			Immutable(),
+		field.UUID("target_id", uuid.UUID{}).
+			Immutable(),
+		// Details needed to describe the action without loading the target
+		field.JSON("metadata", map[string]any{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Activity.
+func (Activity) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Activity belongs to an organization
+		edge.From("organization", Organization.Type).
+			Ref("activities").
+			Field("organization_id").
+			Unique().
+			Required().
+			Immutable(),
+		// User who performed the action
+		edge.To("actor", User.Type).
+			Field("actor_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Activity.
+func (Activity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "created_at"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+// An API key authenticates service-to-service requests to a single organization.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		// Leading characters of the key, shown so users can tell their keys apart
+		field.String("key_prefix").
+			Immutable(),
+		// SHA-256 of the key; the key itself is only shown once, when it is created
+		field.String("hashed_key").
+			Unique().
+			Immutable().
+			Sensitive(),
+		// What the key may do, e.g. "read" and "write"
+		field.Strings("scopes"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.UUID("created_by_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		// API key belongs to an organization
+		edge.From("organization", Organization.Type).
+			Ref("api_keys").
+			Field("organization_id").
+			Unique().
+			Required().
+			Immutable(),
+		// User who created the key; requests made with it act on their behalf
+		edge.To("created_by", User.Type).
+			Field("created_by_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id"),
+	}
+}
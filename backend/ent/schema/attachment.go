@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Attachment holds the schema definition for the Attachment entity.
+// Only the metadata is stored here; the file itself lives in object storage under storage_key.
+type Attachment struct {
+	ent.Schema
+}
+
+// Fields of the Attachment.
+func (Attachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("task_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("uploader_id", uuid.UUID{}).
+			Immutable(),
+		field.String("filename").
+			NotEmpty(),
+		field.String("content_type").
+			NotEmpty(),
+		// Size in bytes, as reported by the storage backend
+		field.Int64("size").
+			NonNegative(),
+		field.String("storage_key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Attachment.
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Attachment belongs to a task
+		edge.From("task", Task.Type).
+			Ref("attachments").
+			Field("task_id").
+			Unique().
+			Required().
+			Immutable(),
+		// User who uploaded the file
+		edge.To("uploader", User.Type).
+			Field("uploader_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Attachment.
+func (Attachment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "created_at"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Comment holds the schema definition for the Comment entity.
+type Comment struct {
+	ent.Schema
+}
+
+// Fields of the Comment.
+func (Comment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("task_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("author_id", uuid.UUID{}).
+			Immutable(),
+		field.Text("body").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Comment.
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Comment belongs to a task
+		edge.From("task", Task.Type).
+			Ref("comments").
+			Field("task_id").
+			Unique().
+			Required().
+			Immutable(),
+		// User who wrote the comment
+		edge.To("author", User.Type).
+			Field("author_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "created_at"),
+	}
+}
@@ -5,3 +5,6 @@ import "regexp"
 // slugRegex validates URL-friendly slugs (lowercase letters, numbers, and hyphens)
 var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 
+
+// colorRegex validates hex colors in #rrggbb form
+var colorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EmailJob holds the schema definition for the EmailJob entity.
+// Emails are queued as jobs and delivered by a background worker.
+type EmailJob struct {
+	ent.Schema
+}
+
+// Fields of the EmailJob.
+func (EmailJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("to").
+			NotEmpty().
+			Immutable(),
+		field.String("subject").
+			Immutable(),
+		field.Text("html").
+			Immutable(),
+		field.Text("text").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "sending", "sent", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Text("last_error").
+			Optional().
+			Nillable(),
+		// When the job is next due; pushed back exponentially after each failed attempt
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the EmailJob.
+func (EmailJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Label holds the schema definition for the Label entity.
+type Label struct {
+	ent.Schema
+}
+
+// Fields of the Label.
+func (Label) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("project_id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(50),
+		field.String("color").
+			Match(colorRegex),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Label.
+func (Label) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Label belongs to a project
+		edge.From("project", Project.Type).
+			Ref("labels").
+			Field("project_id").
+			Unique().
+			Required().
+			Immutable(),
+		// Tasks tagged with this label
+		edge.From("tasks", Task.Type).
+			Ref("labels"),
+	}
+}
+
+// Indexes of the Label.
+func (Label) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name").Unique(),
+	}
+}
@@ -27,6 +27,18 @@ func (Organization) Fields() []ent.Field {
 			Unique().
 			NotEmpty().
 			Match(slugRegex),
+		// Permission members get on public projects they haven't been given an explicit one on
+		field.Enum("default_project_permission").
+			Values("view", "edit").
+			Default("view"),
+		// Most members the organization can have, counting pending invites; unset means no limit
+		field.Int("max_members").
+			Optional().
+			Nillable().
+			Positive(),
+		// Per-organization feature toggles keyed by feature name
+		field.JSON("feature_flags", map[string]bool{}).
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -47,6 +59,12 @@ func (Organization) Edges() []ent.Edge {
 		edge.To("projects", Project.Type),
 		// Organization has many invites
 		edge.To("invites", Invite.Type),
+		// Organization has an activity feed
+		edge.To("activities", Activity.Type),
+		// Organization has many webhooks
+		edge.To("webhooks", Webhook.Type),
+		// Organization has many API keys
+		edge.To("api_keys", APIKey.Type),
 		// Users who last accessed this organization
 		edge.From("last_accessed_by", User.Type).
 			Ref("last_organization"),
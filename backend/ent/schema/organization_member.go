@@ -30,6 +30,9 @@ func (OrganizationMember) Fields() []ent.Field {
 		field.Enum("role").
 			Values("owner", "admin", "member").
 			Default("member"),
+		// Read-only members can view the organization but not modify anything
+		field.Bool("read_only").
+			Default(false),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -15,6 +16,13 @@ type Project struct {
 	ent.Schema
 }
 
+// Mixin of the Project.
+func (Project) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Fields of the Project.
 func (Project) Fields() []ent.Field {
 	return []ent.Field{
@@ -26,6 +34,17 @@ func (Project) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bool("is_private").
 			Default(false),
+		// The project created together with the organization, which cannot be deleted
+		field.Bool("is_default").
+			Default(false),
+		// Manual ordering within the organization (fractional, so reordering touches one row)
+		field.Float("position").
+			Optional().
+			Nillable(),
+		// Nil for projects created before creators were recorded
+		field.UUID("created_by_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -50,6 +69,14 @@ func (Project) Edges() []ent.Edge {
 			Through("project_memberships", ProjectMember.Type),
 		// Project has many invites
 		edge.To("invites", Invite.Type),
+		// Project has many tasks
+		edge.To("tasks", Task.Type),
+		// Project has many labels
+		edge.To("labels", Label.Type),
+		// User who created the project
+		edge.To("created_by", User.Type).
+			Field("created_by_id").
+			Unique(),
 		// Users who last accessed this project
 		edge.From("last_accessed_by", User.Type).
 			Ref("last_project"),
@@ -59,7 +86,11 @@ func (Project) Edges() []ent.Edge {
 // Indexes of the Project.
 func (Project) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("organization_id", "name"),
+		// Names are unique among an organization's projects that aren't deleted
+		index.Fields("organization_id", "name").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("organization_id", "position"),
 	}
 }
 
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Task holds the schema definition for the Task entity.
+type Task struct {
+	ent.Schema
+}
+
+// Mixin of the Task.
+func (Task) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Task.
+func (Task) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("project_id", uuid.UUID{}),
+		field.String("title").
+			NotEmpty(),
+		field.Text("description").
+			Default(""),
+		field.Enum("status").
+			Values("todo", "in_progress", "done").
+			Default("todo"),
+		field.Enum("priority").
+			Values("low", "medium", "high", "urgent").
+			Default("medium"),
+		field.UUID("created_by_id", uuid.UUID{}),
+		field.UUID("assignee_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("due_date").
+			Optional().
+			Nillable(),
+		// Manual ordering within the task's status column (fractional, so reordering touches one row)
+		field.Float("position").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Task.
+func (Task) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Task belongs to a project
+		edge.From("project", Project.Type).
+			Ref("tasks").
+			Field("project_id").
+			Unique().
+			Required(),
+		// User who created the task
+		edge.To("created_by", User.Type).
+			Field("created_by_id").
+			Unique().
+			Required(),
+		// User the task is assigned to
+		edge.To("assignee", User.Type).
+			Field("assignee_id").
+			Unique(),
+		// Audit trail of status transitions
+		edge.To("status_changes", TaskStatusChange.Type),
+		// Discussion on the task
+		edge.To("comments", Comment.Type),
+		// Labels attached to the task
+		edge.To("labels", Label.Type),
+		// Files attached to the task
+		edge.To("attachments", Attachment.Type),
+		// Users notified of changes to the task through TaskWatcher
+		edge.From("watchers", User.Type).
+			Ref("watched_tasks").
+			Through("task_watchers", TaskWatcher.Type),
+	}
+}
+
+// Indexes of the Task.
+func (Task) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "status"),
+		index.Fields("assignee_id"),
+		index.Fields("project_id", "due_date"),
+		index.Fields("project_id", "status", "position"),
+	}
+}
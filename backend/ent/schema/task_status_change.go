@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TaskStatusChange holds the schema definition for the TaskStatusChange entity.
+// Each row records one status transition of a task for its audit trail.
+type TaskStatusChange struct {
+	ent.Schema
+}
+
+// Fields of the TaskStatusChange.
+func (TaskStatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("task_id", uuid.UUID{}).
+			Immutable(),
+		field.Enum("from_status").
+			Values("todo", "in_progress", "done").
+			Immutable(),
+		field.Enum("to_status").
+			Values("todo", "in_progress", "done").
+			Immutable(),
+		field.UUID("changed_by_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TaskStatusChange.
+func (TaskStatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", Task.Type).
+			Ref("status_changes").
+			Field("task_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.To("changed_by", User.Type).
+			Field("changed_by_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the TaskStatusChange.
+func (TaskStatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "created_at"),
+	}
+}
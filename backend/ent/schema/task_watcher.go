@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TaskWatcher holds the schema definition for the TaskWatcher entity.
+// This is a join table between User and Task for users notified of changes to the task.
+type TaskWatcher struct {
+	ent.Schema
+}
+
+// Fields of the TaskWatcher.
+func (TaskWatcher) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("task_id", uuid.UUID{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TaskWatcher.
+func (TaskWatcher) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Field("user_id"),
+		edge.To("task", Task.Type).
+			Unique().
+			Required().
+			Field("task_id"),
+	}
+}
+
+// Indexes of the TaskWatcher.
+func (TaskWatcher) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "task_id").Unique(),
+	}
+}
@@ -29,12 +29,23 @@ func (User) Fields() []ent.Field {
 			Sensitive(),
 		field.String("display_name").
 			NotEmpty(),
+		field.String("timezone").
+			Default("UTC").
+			NotEmpty(),
 		field.UUID("last_org_id", uuid.UUID{}).
 			Optional().
 			Nillable(),
 		field.UUID("last_project_id", uuid.UUID{}).
 			Optional().
 			Nillable(),
+		// Refresh tokens issued before this are rejected, ending other sessions
+		field.Time("password_changed_at").
+			Optional().
+			Nillable(),
+		// Set when the account has been anonymized (right to be forgotten)
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -53,6 +64,11 @@ func (User) Edges() []ent.Edge {
 		// User belongs to many projects through ProjectMember
 		edge.To("projects", Project.Type).
 			Through("project_memberships", ProjectMember.Type),
+		// User follows tasks through TaskWatcher
+		edge.To("watched_tasks", Task.Type).
+			Through("task_watchers", TaskWatcher.Type),
+		// In-app notifications for the user
+		edge.To("notifications", Notification.Type),
 		// User sent invites
 		edge.To("sent_invites", Invite.Type),
 		// Last accessed organization
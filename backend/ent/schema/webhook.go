@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook receives a signed POST for each subscribed event in its organization.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		// Key for the HMAC-SHA256 signature sent with every delivery
+		field.String("secret").
+			NotEmpty().
+			Sensitive(),
+		// Event names the webhook is subscribed to, e.g. "task.created"
+		field.Strings("events"),
+		field.Bool("active").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Webhook belongs to an organization
+		edge.From("organization", Organization.Type).
+			Ref("webhooks").
+			Field("organization_id").
+			Unique().
+			Required().
+			Immutable(),
+		// Webhook has many delivery attempts
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
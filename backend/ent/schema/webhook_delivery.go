@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each event sent to a webhook is queued as a delivery and posted by a background worker.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("webhook_id", uuid.UUID{}).
+			Immutable(),
+		field.String("event").
+			NotEmpty().
+			Immutable(),
+		// JSON body posted to the webhook, stored as sent so retries are signed identically
+		field.Text("payload").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "sending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		// HTTP status code of the last attempt, unset if no response was received
+		field.Int("status_code").
+			Optional().
+			Nillable(),
+		field.Text("last_error").
+			Optional().
+			Nillable(),
+		// When the delivery is next due; pushed back exponentially after each failed attempt
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Delivery belongs to a webhook
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("webhook_id", "created_at"),
+	}
+}
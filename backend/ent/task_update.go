@@ -0,0 +1,1938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/attachment"
+	"backend/ent/comment"
+	"backend/ent/label"
+	"backend/ent/predicate"
+	"backend/ent/project"
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/taskwatcher"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskUpdate is the builder for updating Task entities.
+type TaskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskUpdate builder.
+func (tu *TaskUpdate) Where(ps ...predicate.Task) *TaskUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TaskUpdate) SetDeletedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDeletedAt(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TaskUpdate) ClearDeletedAt() *TaskUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetProjectID sets the "project_id" field.
+func (tu *TaskUpdate) SetProjectID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetProjectID(u)
+	return tu
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableProjectID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetProjectID(*u)
+	}
+	return tu
+}
+
+// SetTitle sets the "title" field.
+func (tu *TaskUpdate) SetTitle(s string) *TaskUpdate {
+	tu.mutation.SetTitle(s)
+	return tu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableTitle(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetTitle(*s)
+	}
+	return tu
+}
+
+// SetDescription sets the "description" field.
+func (tu *TaskUpdate) SetDescription(s string) *TaskUpdate {
+	tu.mutation.SetDescription(s)
+	return tu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDescription(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetDescription(*s)
+	}
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TaskUpdate) SetStatus(t task.Status) *TaskUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStatus(t *task.Status) *TaskUpdate {
+	if t != nil {
+		tu.SetStatus(*t)
+	}
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TaskUpdate) SetPriority(t task.Priority) *TaskUpdate {
+	tu.mutation.SetPriority(t)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePriority(t *task.Priority) *TaskUpdate {
+	if t != nil {
+		tu.SetPriority(*t)
+	}
+	return tu
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (tu *TaskUpdate) SetCreatedByID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetCreatedByID(u)
+	return tu
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCreatedByID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetCreatedByID(*u)
+	}
+	return tu
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (tu *TaskUpdate) SetAssigneeID(u uuid.UUID) *TaskUpdate {
+	tu.mutation.SetAssigneeID(u)
+	return tu
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableAssigneeID(u *uuid.UUID) *TaskUpdate {
+	if u != nil {
+		tu.SetAssigneeID(*u)
+	}
+	return tu
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (tu *TaskUpdate) ClearAssigneeID() *TaskUpdate {
+	tu.mutation.ClearAssigneeID()
+	return tu
+}
+
+// SetDueDate sets the "due_date" field.
+func (tu *TaskUpdate) SetDueDate(t time.Time) *TaskUpdate {
+	tu.mutation.SetDueDate(t)
+	return tu
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableDueDate(t *time.Time) *TaskUpdate {
+	if t != nil {
+		tu.SetDueDate(*t)
+	}
+	return tu
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tu *TaskUpdate) ClearDueDate() *TaskUpdate {
+	tu.mutation.ClearDueDate()
+	return tu
+}
+
+// SetPosition sets the "position" field.
+func (tu *TaskUpdate) SetPosition(f float64) *TaskUpdate {
+	tu.mutation.ResetPosition()
+	tu.mutation.SetPosition(f)
+	return tu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePosition(f *float64) *TaskUpdate {
+	if f != nil {
+		tu.SetPosition(*f)
+	}
+	return tu
+}
+
+// AddPosition adds f to the "position" field.
+func (tu *TaskUpdate) AddPosition(f float64) *TaskUpdate {
+	tu.mutation.AddPosition(f)
+	return tu
+}
+
+// ClearPosition clears the value of the "position" field.
+func (tu *TaskUpdate) ClearPosition() *TaskUpdate {
+	tu.mutation.ClearPosition()
+	return tu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tu *TaskUpdate) SetUpdatedAt(t time.Time) *TaskUpdate {
+	tu.mutation.SetUpdatedAt(t)
+	return tu
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tu *TaskUpdate) SetProject(p *Project) *TaskUpdate {
+	return tu.SetProjectID(p.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (tu *TaskUpdate) SetCreatedBy(u *User) *TaskUpdate {
+	return tu.SetCreatedByID(u.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (tu *TaskUpdate) SetAssignee(u *User) *TaskUpdate {
+	return tu.SetAssigneeID(u.ID)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the TaskStatusChange entity by IDs.
+func (tu *TaskUpdate) AddStatusChangeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddStatusChangeIDs(ids...)
+	return tu
+}
+
+// AddStatusChanges adds the "status_changes" edges to the TaskStatusChange entity.
+func (tu *TaskUpdate) AddStatusChanges(t ...*TaskStatusChange) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddStatusChangeIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tu *TaskUpdate) AddCommentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddCommentIDs(ids...)
+	return tu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tu *TaskUpdate) AddComments(c ...*Comment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.AddCommentIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tu *TaskUpdate) AddLabelIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddLabelIDs(ids...)
+	return tu
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tu *TaskUpdate) AddLabels(l ...*Label) *TaskUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.AddLabelIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (tu *TaskUpdate) AddAttachmentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddAttachmentIDs(ids...)
+	return tu
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (tu *TaskUpdate) AddAttachments(a ...*Attachment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.AddAttachmentIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the User entity by IDs.
+func (tu *TaskUpdate) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddWatcherIDs(ids...)
+	return tu
+}
+
+// AddWatchers adds the "watchers" edges to the User entity.
+func (tu *TaskUpdate) AddWatchers(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.AddWatcherIDs(ids...)
+}
+
+// AddTaskWatcherIDs adds the "task_watchers" edge to the TaskWatcher entity by IDs.
+func (tu *TaskUpdate) AddTaskWatcherIDs(ids ...int) *TaskUpdate {
+	tu.mutation.AddTaskWatcherIDs(ids...)
+	return tu
+}
+
+// AddTaskWatchers adds the "task_watchers" edges to the TaskWatcher entity.
+func (tu *TaskUpdate) AddTaskWatchers(t ...*TaskWatcher) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddTaskWatcherIDs(ids...)
+}
+
+// Mutation returns the TaskMutation object of the builder.
+func (tu *TaskUpdate) Mutation() *TaskMutation {
+	return tu.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tu *TaskUpdate) ClearProject() *TaskUpdate {
+	tu.mutation.ClearProject()
+	return tu
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (tu *TaskUpdate) ClearCreatedBy() *TaskUpdate {
+	tu.mutation.ClearCreatedBy()
+	return tu
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (tu *TaskUpdate) ClearAssignee() *TaskUpdate {
+	tu.mutation.ClearAssignee()
+	return tu
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the TaskStatusChange entity.
+func (tu *TaskUpdate) ClearStatusChanges() *TaskUpdate {
+	tu.mutation.ClearStatusChanges()
+	return tu
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to TaskStatusChange entities by IDs.
+func (tu *TaskUpdate) RemoveStatusChangeIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveStatusChangeIDs(ids...)
+	return tu
+}
+
+// RemoveStatusChanges removes "status_changes" edges to TaskStatusChange entities.
+func (tu *TaskUpdate) RemoveStatusChanges(t ...*TaskStatusChange) *TaskUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tu *TaskUpdate) ClearComments() *TaskUpdate {
+	tu.mutation.ClearComments()
+	return tu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tu *TaskUpdate) RemoveCommentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveCommentIDs(ids...)
+	return tu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tu *TaskUpdate) RemoveComments(c ...*Comment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tu.RemoveCommentIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (tu *TaskUpdate) ClearLabels() *TaskUpdate {
+	tu.mutation.ClearLabels()
+	return tu
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (tu *TaskUpdate) RemoveLabelIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveLabelIDs(ids...)
+	return tu
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (tu *TaskUpdate) RemoveLabels(l ...*Label) *TaskUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tu.RemoveLabelIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (tu *TaskUpdate) ClearAttachments() *TaskUpdate {
+	tu.mutation.ClearAttachments()
+	return tu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (tu *TaskUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveAttachmentIDs(ids...)
+	return tu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (tu *TaskUpdate) RemoveAttachments(a ...*Attachment) *TaskUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the User entity.
+func (tu *TaskUpdate) ClearWatchers() *TaskUpdate {
+	tu.mutation.ClearWatchers()
+	return tu
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to User entities by IDs.
+func (tu *TaskUpdate) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveWatcherIDs(ids...)
+	return tu
+}
+
+// RemoveWatchers removes "watchers" edges to User entities.
+func (tu *TaskUpdate) RemoveWatchers(u ...*User) *TaskUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tu.RemoveWatcherIDs(ids...)
+}
+
+// ClearTaskWatchers clears all "task_watchers" edges to the TaskWatcher entity.
+func (tu *TaskUpdate) ClearTaskWatchers() *TaskUpdate {
+	tu.mutation.ClearTaskWatchers()
+	return tu
+}
+
+// RemoveTaskWatcherIDs removes the "task_watchers" edge to TaskWatcher entities by IDs.
+func (tu *TaskUpdate) RemoveTaskWatcherIDs(ids ...int) *TaskUpdate {
+	tu.mutation.RemoveTaskWatcherIDs(ids...)
+	return tu
+}
+
+// RemoveTaskWatchers removes "task_watchers" edges to TaskWatcher entities.
+func (tu *TaskUpdate) RemoveTaskWatchers(t ...*TaskWatcher) *TaskUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveTaskWatcherIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
+	tu.defaults()
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TaskUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TaskUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tu *TaskUpdate) defaults() {
+	if _, ok := tu.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TaskUpdate) check() error {
+	if v, ok := tu.mutation.Title(); ok {
+		if err := task.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := task.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	if tu.mutation.ProjectCleared() && len(tu.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Task.project"`)
+	}
+	if tu.mutation.CreatedByCleared() && len(tu.mutation.CreatedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Task.created_by"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tu *TaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdate {
+	tu.modifiers = append(tu.modifiers, modifiers...)
+	return tu
+}
+
+func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tu.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Title(); ok {
+		_spec.SetField(task.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Description(); ok {
+		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.DueDate(); ok {
+		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	}
+	if tu.mutation.DueDateCleared() {
+		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tu.mutation.Position(); ok {
+		_spec.SetField(task.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedPosition(); ok {
+		_spec.AddField(task.FieldPosition, field.TypeFloat64, value)
+	}
+	if tu.mutation.PositionCleared() {
+		_spec.ClearField(task.FieldPosition, field.TypeFloat64)
+	}
+	if value, ok := tu.mutation.UpdatedAt(); ok {
+		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tu.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.CreatedByTable,
+			Columns: []string{task.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.CreatedByTable,
+			Columns: []string{task.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.AssigneeTable,
+			Columns: []string{task.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.AssigneeTable,
+			Columns: []string{task.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !tu.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tu.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &TaskWatcherCreate{config: tu.config, mutation: newTaskWatcherMutation(tu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tu.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TaskWatcherCreate{config: tu.config, mutation: newTaskWatcherMutation(tu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TaskWatcherCreate{config: tu.config, mutation: newTaskWatcherMutation(tu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.TaskWatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedTaskWatchersIDs(); len(nodes) > 0 && !tu.mutation.TaskWatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.TaskWatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TaskUpdateOne is the builder for updating a single Task entity.
+type TaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TaskUpdateOne) SetDeletedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDeletedAt(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TaskUpdateOne) ClearDeletedAt() *TaskUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetProjectID sets the "project_id" field.
+func (tuo *TaskUpdateOne) SetProjectID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetProjectID(u)
+	return tuo
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableProjectID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetProjectID(*u)
+	}
+	return tuo
+}
+
+// SetTitle sets the "title" field.
+func (tuo *TaskUpdateOne) SetTitle(s string) *TaskUpdateOne {
+	tuo.mutation.SetTitle(s)
+	return tuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableTitle(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetTitle(*s)
+	}
+	return tuo
+}
+
+// SetDescription sets the "description" field.
+func (tuo *TaskUpdateOne) SetDescription(s string) *TaskUpdateOne {
+	tuo.mutation.SetDescription(s)
+	return tuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDescription(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetDescription(*s)
+	}
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TaskUpdateOne) SetStatus(t task.Status) *TaskUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatus(t *task.Status) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TaskUpdateOne) SetPriority(t task.Priority) *TaskUpdateOne {
+	tuo.mutation.SetPriority(t)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePriority(t *task.Priority) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetPriority(*t)
+	}
+	return tuo
+}
+
+// SetCreatedByID sets the "created_by_id" field.
+func (tuo *TaskUpdateOne) SetCreatedByID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetCreatedByID(u)
+	return tuo
+}
+
+// SetNillableCreatedByID sets the "created_by_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCreatedByID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetCreatedByID(*u)
+	}
+	return tuo
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (tuo *TaskUpdateOne) SetAssigneeID(u uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.SetAssigneeID(u)
+	return tuo
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableAssigneeID(u *uuid.UUID) *TaskUpdateOne {
+	if u != nil {
+		tuo.SetAssigneeID(*u)
+	}
+	return tuo
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (tuo *TaskUpdateOne) ClearAssigneeID() *TaskUpdateOne {
+	tuo.mutation.ClearAssigneeID()
+	return tuo
+}
+
+// SetDueDate sets the "due_date" field.
+func (tuo *TaskUpdateOne) SetDueDate(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetDueDate(t)
+	return tuo
+}
+
+// SetNillableDueDate sets the "due_date" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableDueDate(t *time.Time) *TaskUpdateOne {
+	if t != nil {
+		tuo.SetDueDate(*t)
+	}
+	return tuo
+}
+
+// ClearDueDate clears the value of the "due_date" field.
+func (tuo *TaskUpdateOne) ClearDueDate() *TaskUpdateOne {
+	tuo.mutation.ClearDueDate()
+	return tuo
+}
+
+// SetPosition sets the "position" field.
+func (tuo *TaskUpdateOne) SetPosition(f float64) *TaskUpdateOne {
+	tuo.mutation.ResetPosition()
+	tuo.mutation.SetPosition(f)
+	return tuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePosition(f *float64) *TaskUpdateOne {
+	if f != nil {
+		tuo.SetPosition(*f)
+	}
+	return tuo
+}
+
+// AddPosition adds f to the "position" field.
+func (tuo *TaskUpdateOne) AddPosition(f float64) *TaskUpdateOne {
+	tuo.mutation.AddPosition(f)
+	return tuo
+}
+
+// ClearPosition clears the value of the "position" field.
+func (tuo *TaskUpdateOne) ClearPosition() *TaskUpdateOne {
+	tuo.mutation.ClearPosition()
+	return tuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tuo *TaskUpdateOne) SetUpdatedAt(t time.Time) *TaskUpdateOne {
+	tuo.mutation.SetUpdatedAt(t)
+	return tuo
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) SetProject(p *Project) *TaskUpdateOne {
+	return tuo.SetProjectID(p.ID)
+}
+
+// SetCreatedBy sets the "created_by" edge to the User entity.
+func (tuo *TaskUpdateOne) SetCreatedBy(u *User) *TaskUpdateOne {
+	return tuo.SetCreatedByID(u.ID)
+}
+
+// SetAssignee sets the "assignee" edge to the User entity.
+func (tuo *TaskUpdateOne) SetAssignee(u *User) *TaskUpdateOne {
+	return tuo.SetAssigneeID(u.ID)
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the TaskStatusChange entity by IDs.
+func (tuo *TaskUpdateOne) AddStatusChangeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddStatusChangeIDs(ids...)
+	return tuo
+}
+
+// AddStatusChanges adds the "status_changes" edges to the TaskStatusChange entity.
+func (tuo *TaskUpdateOne) AddStatusChanges(t ...*TaskStatusChange) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddStatusChangeIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (tuo *TaskUpdateOne) AddCommentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddCommentIDs(ids...)
+	return tuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) AddComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.AddCommentIDs(ids...)
+}
+
+// AddLabelIDs adds the "labels" edge to the Label entity by IDs.
+func (tuo *TaskUpdateOne) AddLabelIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddLabelIDs(ids...)
+	return tuo
+}
+
+// AddLabels adds the "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) AddLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.AddLabelIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (tuo *TaskUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddAttachmentIDs(ids...)
+	return tuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (tuo *TaskUpdateOne) AddAttachments(a ...*Attachment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.AddAttachmentIDs(ids...)
+}
+
+// AddWatcherIDs adds the "watchers" edge to the User entity by IDs.
+func (tuo *TaskUpdateOne) AddWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddWatcherIDs(ids...)
+	return tuo
+}
+
+// AddWatchers adds the "watchers" edges to the User entity.
+func (tuo *TaskUpdateOne) AddWatchers(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.AddWatcherIDs(ids...)
+}
+
+// AddTaskWatcherIDs adds the "task_watchers" edge to the TaskWatcher entity by IDs.
+func (tuo *TaskUpdateOne) AddTaskWatcherIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.AddTaskWatcherIDs(ids...)
+	return tuo
+}
+
+// AddTaskWatchers adds the "task_watchers" edges to the TaskWatcher entity.
+func (tuo *TaskUpdateOne) AddTaskWatchers(t ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddTaskWatcherIDs(ids...)
+}
+
+// Mutation returns the TaskMutation object of the builder.
+func (tuo *TaskUpdateOne) Mutation() *TaskMutation {
+	return tuo.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (tuo *TaskUpdateOne) ClearProject() *TaskUpdateOne {
+	tuo.mutation.ClearProject()
+	return tuo
+}
+
+// ClearCreatedBy clears the "created_by" edge to the User entity.
+func (tuo *TaskUpdateOne) ClearCreatedBy() *TaskUpdateOne {
+	tuo.mutation.ClearCreatedBy()
+	return tuo
+}
+
+// ClearAssignee clears the "assignee" edge to the User entity.
+func (tuo *TaskUpdateOne) ClearAssignee() *TaskUpdateOne {
+	tuo.mutation.ClearAssignee()
+	return tuo
+}
+
+// ClearStatusChanges clears all "status_changes" edges to the TaskStatusChange entity.
+func (tuo *TaskUpdateOne) ClearStatusChanges() *TaskUpdateOne {
+	tuo.mutation.ClearStatusChanges()
+	return tuo
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to TaskStatusChange entities by IDs.
+func (tuo *TaskUpdateOne) RemoveStatusChangeIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveStatusChangeIDs(ids...)
+	return tuo
+}
+
+// RemoveStatusChanges removes "status_changes" edges to TaskStatusChange entities.
+func (tuo *TaskUpdateOne) RemoveStatusChanges(t ...*TaskStatusChange) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (tuo *TaskUpdateOne) ClearComments() *TaskUpdateOne {
+	tuo.mutation.ClearComments()
+	return tuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (tuo *TaskUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveCommentIDs(ids...)
+	return tuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (tuo *TaskUpdateOne) RemoveComments(c ...*Comment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return tuo.RemoveCommentIDs(ids...)
+}
+
+// ClearLabels clears all "labels" edges to the Label entity.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
+// RemoveLabelIDs removes the "labels" edge to Label entities by IDs.
+func (tuo *TaskUpdateOne) RemoveLabelIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveLabelIDs(ids...)
+	return tuo
+}
+
+// RemoveLabels removes "labels" edges to Label entities.
+func (tuo *TaskUpdateOne) RemoveLabels(l ...*Label) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return tuo.RemoveLabelIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (tuo *TaskUpdateOne) ClearAttachments() *TaskUpdateOne {
+	tuo.mutation.ClearAttachments()
+	return tuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (tuo *TaskUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveAttachmentIDs(ids...)
+	return tuo
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (tuo *TaskUpdateOne) RemoveAttachments(a ...*Attachment) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearWatchers clears all "watchers" edges to the User entity.
+func (tuo *TaskUpdateOne) ClearWatchers() *TaskUpdateOne {
+	tuo.mutation.ClearWatchers()
+	return tuo
+}
+
+// RemoveWatcherIDs removes the "watchers" edge to User entities by IDs.
+func (tuo *TaskUpdateOne) RemoveWatcherIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveWatcherIDs(ids...)
+	return tuo
+}
+
+// RemoveWatchers removes "watchers" edges to User entities.
+func (tuo *TaskUpdateOne) RemoveWatchers(u ...*User) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return tuo.RemoveWatcherIDs(ids...)
+}
+
+// ClearTaskWatchers clears all "task_watchers" edges to the TaskWatcher entity.
+func (tuo *TaskUpdateOne) ClearTaskWatchers() *TaskUpdateOne {
+	tuo.mutation.ClearTaskWatchers()
+	return tuo
+}
+
+// RemoveTaskWatcherIDs removes the "task_watchers" edge to TaskWatcher entities by IDs.
+func (tuo *TaskUpdateOne) RemoveTaskWatcherIDs(ids ...int) *TaskUpdateOne {
+	tuo.mutation.RemoveTaskWatcherIDs(ids...)
+	return tuo
+}
+
+// RemoveTaskWatchers removes "task_watchers" edges to TaskWatcher entities.
+func (tuo *TaskUpdateOne) RemoveTaskWatchers(t ...*TaskWatcher) *TaskUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveTaskWatcherIDs(ids...)
+}
+
+// Where appends a list predicates to the TaskUpdate builder.
+func (tuo *TaskUpdateOne) Where(ps ...predicate.Task) *TaskUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TaskUpdateOne) Select(field string, fields ...string) *TaskUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Task entity.
+func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
+	tuo.defaults()
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TaskUpdateOne) SaveX(ctx context.Context) *Task {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tuo *TaskUpdateOne) defaults() {
+	if _, ok := tuo.mutation.UpdatedAt(); !ok {
+		v := task.UpdateDefaultUpdatedAt()
+		tuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TaskUpdateOne) check() error {
+	if v, ok := tuo.mutation.Title(); ok {
+		if err := task.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := task.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Priority(); ok {
+		if err := task.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+		}
+	}
+	if tuo.mutation.ProjectCleared() && len(tuo.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Task.project"`)
+	}
+	if tuo.mutation.CreatedByCleared() && len(tuo.mutation.CreatedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Task.created_by"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tuo *TaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskUpdateOne {
+	tuo.modifiers = append(tuo.modifiers, modifiers...)
+	return tuo
+}
+
+func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(task.Table, task.Columns, sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Task.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, task.FieldID)
+		for _, f := range fields {
+			if !task.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != task.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		_spec.SetField(task.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(task.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Title(); ok {
+		_spec.SetField(task.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Description(); ok {
+		_spec.SetField(task.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		_spec.SetField(task.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.DueDate(); ok {
+		_spec.SetField(task.FieldDueDate, field.TypeTime, value)
+	}
+	if tuo.mutation.DueDateCleared() {
+		_spec.ClearField(task.FieldDueDate, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.Position(); ok {
+		_spec.SetField(task.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedPosition(); ok {
+		_spec.AddField(task.FieldPosition, field.TypeFloat64, value)
+	}
+	if tuo.mutation.PositionCleared() {
+		_spec.ClearField(task.FieldPosition, field.TypeFloat64)
+	}
+	if value, ok := tuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(task.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   task.ProjectTable,
+			Columns: []string{task.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.CreatedByTable,
+			Columns: []string{task.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.CreatedByTable,
+			Columns: []string{task.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AssigneeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.AssigneeTable,
+			Columns: []string{task.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AssigneeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   task.AssigneeTable,
+			Columns: []string{task.AssigneeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !tuo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.StatusChangesTable,
+			Columns: []string{task.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !tuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.CommentsTable,
+			Columns: []string{task.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedLabelsIDs(); len(nodes) > 0 && !tuo.mutation.LabelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.LabelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   task.LabelsTable,
+			Columns: task.LabelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(label.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !tuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   task.AttachmentsTable,
+			Columns: []string{task.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &TaskWatcherCreate{config: tuo.config, mutation: newTaskWatcherMutation(tuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedWatchersIDs(); len(nodes) > 0 && !tuo.mutation.WatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TaskWatcherCreate{config: tuo.config, mutation: newTaskWatcherMutation(tuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.WatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   task.WatchersTable,
+			Columns: task.WatchersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &TaskWatcherCreate{config: tuo.config, mutation: newTaskWatcherMutation(tuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.TaskWatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedTaskWatchersIDs(); len(nodes) > 0 && !tuo.mutation.TaskWatchersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.TaskWatchersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.TaskWatchersTable,
+			Columns: []string{task.TaskWatchersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(tuo.modifiers...)
+	_node = &Task{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{task.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TaskStatusChange is the model entity for the TaskStatusChange schema.
+type TaskStatusChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus taskstatuschange.FromStatus `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus taskstatuschange.ToStatus `json:"to_status,omitempty"`
+	// ChangedByID holds the value of the "changed_by_id" field.
+	ChangedByID uuid.UUID `json:"changed_by_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TaskStatusChangeQuery when eager-loading is set.
+	Edges        TaskStatusChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TaskStatusChangeEdges holds the relations/edges for other nodes in the graph.
+type TaskStatusChangeEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// ChangedBy holds the value of the changed_by edge.
+	ChangedBy *User `json:"changed_by,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskStatusChangeEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// ChangedByOrErr returns the ChangedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TaskStatusChangeEdges) ChangedByOrErr() (*User, error) {
+	if e.ChangedBy != nil {
+		return e.ChangedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "changed_by"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskStatusChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskstatuschange.FieldFromStatus, taskstatuschange.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case taskstatuschange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case taskstatuschange.FieldID, taskstatuschange.FieldTaskID, taskstatuschange.FieldChangedByID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskStatusChange fields.
+func (tsc *TaskStatusChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskstatuschange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tsc.ID = *value
+			}
+		case taskstatuschange.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				tsc.TaskID = *value
+			}
+		case taskstatuschange.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				tsc.FromStatus = taskstatuschange.FromStatus(value.String)
+			}
+		case taskstatuschange.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				tsc.ToStatus = taskstatuschange.ToStatus(value.String)
+			}
+		case taskstatuschange.FieldChangedByID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by_id", values[i])
+			} else if value != nil {
+				tsc.ChangedByID = *value
+			}
+		case taskstatuschange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tsc.CreatedAt = value.Time
+			}
+		default:
+			tsc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskStatusChange.
+// This includes values selected through modifiers, order, etc.
+func (tsc *TaskStatusChange) Value(name string) (ent.Value, error) {
+	return tsc.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the TaskStatusChange entity.
+func (tsc *TaskStatusChange) QueryTask() *TaskQuery {
+	return NewTaskStatusChangeClient(tsc.config).QueryTask(tsc)
+}
+
+// QueryChangedBy queries the "changed_by" edge of the TaskStatusChange entity.
+func (tsc *TaskStatusChange) QueryChangedBy() *UserQuery {
+	return NewTaskStatusChangeClient(tsc.config).QueryChangedBy(tsc)
+}
+
+// Update returns a builder for updating this TaskStatusChange.
+// Note that you need to call TaskStatusChange.Unwrap() before calling this method if this TaskStatusChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tsc *TaskStatusChange) Update() *TaskStatusChangeUpdateOne {
+	return NewTaskStatusChangeClient(tsc.config).UpdateOne(tsc)
+}
+
+// Unwrap unwraps the TaskStatusChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tsc *TaskStatusChange) Unwrap() *TaskStatusChange {
+	_tx, ok := tsc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskStatusChange is not a transactional entity")
+	}
+	tsc.config.driver = _tx.drv
+	return tsc
+}
+
+// String implements the fmt.Stringer.
+func (tsc *TaskStatusChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskStatusChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tsc.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsc.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(fmt.Sprintf("%v", tsc.FromStatus))
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", tsc.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("changed_by_id=")
+	builder.WriteString(fmt.Sprintf("%v", tsc.ChangedByID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tsc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskStatusChanges is a parsable slice of TaskStatusChange.
+type TaskStatusChanges []*TaskStatusChange
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskStatusChangeCreate is the builder for creating a TaskStatusChange entity.
+type TaskStatusChangeCreate struct {
+	config
+	mutation *TaskStatusChangeMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tscc *TaskStatusChangeCreate) SetTaskID(u uuid.UUID) *TaskStatusChangeCreate {
+	tscc.mutation.SetTaskID(u)
+	return tscc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (tscc *TaskStatusChangeCreate) SetFromStatus(ts taskstatuschange.FromStatus) *TaskStatusChangeCreate {
+	tscc.mutation.SetFromStatus(ts)
+	return tscc
+}
+
+// SetToStatus sets the "to_status" field.
+func (tscc *TaskStatusChangeCreate) SetToStatus(ts taskstatuschange.ToStatus) *TaskStatusChangeCreate {
+	tscc.mutation.SetToStatus(ts)
+	return tscc
+}
+
+// SetChangedByID sets the "changed_by_id" field.
+func (tscc *TaskStatusChangeCreate) SetChangedByID(u uuid.UUID) *TaskStatusChangeCreate {
+	tscc.mutation.SetChangedByID(u)
+	return tscc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tscc *TaskStatusChangeCreate) SetCreatedAt(t time.Time) *TaskStatusChangeCreate {
+	tscc.mutation.SetCreatedAt(t)
+	return tscc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tscc *TaskStatusChangeCreate) SetNillableCreatedAt(t *time.Time) *TaskStatusChangeCreate {
+	if t != nil {
+		tscc.SetCreatedAt(*t)
+	}
+	return tscc
+}
+
+// SetID sets the "id" field.
+func (tscc *TaskStatusChangeCreate) SetID(u uuid.UUID) *TaskStatusChangeCreate {
+	tscc.mutation.SetID(u)
+	return tscc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tscc *TaskStatusChangeCreate) SetNillableID(u *uuid.UUID) *TaskStatusChangeCreate {
+	if u != nil {
+		tscc.SetID(*u)
+	}
+	return tscc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (tscc *TaskStatusChangeCreate) SetTask(t *Task) *TaskStatusChangeCreate {
+	return tscc.SetTaskID(t.ID)
+}
+
+// SetChangedBy sets the "changed_by" edge to the User entity.
+func (tscc *TaskStatusChangeCreate) SetChangedBy(u *User) *TaskStatusChangeCreate {
+	return tscc.SetChangedByID(u.ID)
+}
+
+// Mutation returns the TaskStatusChangeMutation object of the builder.
+func (tscc *TaskStatusChangeCreate) Mutation() *TaskStatusChangeMutation {
+	return tscc.mutation
+}
+
+// Save creates the TaskStatusChange in the database.
+func (tscc *TaskStatusChangeCreate) Save(ctx context.Context) (*TaskStatusChange, error) {
+	tscc.defaults()
+	return withHooks(ctx, tscc.sqlSave, tscc.mutation, tscc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tscc *TaskStatusChangeCreate) SaveX(ctx context.Context) *TaskStatusChange {
+	v, err := tscc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tscc *TaskStatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := tscc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscc *TaskStatusChangeCreate) ExecX(ctx context.Context) {
+	if err := tscc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tscc *TaskStatusChangeCreate) defaults() {
+	if _, ok := tscc.mutation.CreatedAt(); !ok {
+		v := taskstatuschange.DefaultCreatedAt()
+		tscc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tscc.mutation.ID(); !ok {
+		v := taskstatuschange.DefaultID()
+		tscc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tscc *TaskStatusChangeCreate) check() error {
+	if _, ok := tscc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskStatusChange.task_id"`)}
+	}
+	if _, ok := tscc.mutation.FromStatus(); !ok {
+		return &ValidationError{Name: "from_status", err: errors.New(`ent: missing required field "TaskStatusChange.from_status"`)}
+	}
+	if v, ok := tscc.mutation.FromStatus(); ok {
+		if err := taskstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "TaskStatusChange.from_status": %w`, err)}
+		}
+	}
+	if _, ok := tscc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "TaskStatusChange.to_status"`)}
+	}
+	if v, ok := tscc.mutation.ToStatus(); ok {
+		if err := taskstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "TaskStatusChange.to_status": %w`, err)}
+		}
+	}
+	if _, ok := tscc.mutation.ChangedByID(); !ok {
+		return &ValidationError{Name: "changed_by_id", err: errors.New(`ent: missing required field "TaskStatusChange.changed_by_id"`)}
+	}
+	if _, ok := tscc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskStatusChange.created_at"`)}
+	}
+	if len(tscc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskStatusChange.task"`)}
+	}
+	if len(tscc.mutation.ChangedByIDs()) == 0 {
+		return &ValidationError{Name: "changed_by", err: errors.New(`ent: missing required edge "TaskStatusChange.changed_by"`)}
+	}
+	return nil
+}
+
+func (tscc *TaskStatusChangeCreate) sqlSave(ctx context.Context) (*TaskStatusChange, error) {
+	if err := tscc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tscc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tscc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tscc.mutation.id = &_node.ID
+	tscc.mutation.done = true
+	return _node, nil
+}
+
+func (tscc *TaskStatusChangeCreate) createSpec() (*TaskStatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskStatusChange{config: tscc.config}
+		_spec = sqlgraph.NewCreateSpec(taskstatuschange.Table, sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID))
+	)
+	if id, ok := tscc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tscc.mutation.FromStatus(); ok {
+		_spec.SetField(taskstatuschange.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = value
+	}
+	if value, ok := tscc.mutation.ToStatus(); ok {
+		_spec.SetField(taskstatuschange.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := tscc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskstatuschange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tscc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   taskstatuschange.TaskTable,
+			Columns: []string{taskstatuschange.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tscc.mutation.ChangedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskstatuschange.ChangedByTable,
+			Columns: []string{taskstatuschange.ChangedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChangedByID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskStatusChangeCreateBulk is the builder for creating many TaskStatusChange entities in bulk.
+type TaskStatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*TaskStatusChangeCreate
+}
+
+// Save creates the TaskStatusChange entities in the database.
+func (tsccb *TaskStatusChangeCreateBulk) Save(ctx context.Context) ([]*TaskStatusChange, error) {
+	if tsccb.err != nil {
+		return nil, tsccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tsccb.builders))
+	nodes := make([]*TaskStatusChange, len(tsccb.builders))
+	mutators := make([]Mutator, len(tsccb.builders))
+	for i := range tsccb.builders {
+		func(i int, root context.Context) {
+			builder := tsccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskStatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tsccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tsccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tsccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tsccb *TaskStatusChangeCreateBulk) SaveX(ctx context.Context) []*TaskStatusChange {
+	v, err := tsccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tsccb *TaskStatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := tsccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tsccb *TaskStatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := tsccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
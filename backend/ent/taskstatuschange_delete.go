@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/taskstatuschange"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskStatusChangeDelete is the builder for deleting a TaskStatusChange entity.
+type TaskStatusChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskStatusChangeMutation
+}
+
+// Where appends a list predicates to the TaskStatusChangeDelete builder.
+func (tscd *TaskStatusChangeDelete) Where(ps ...predicate.TaskStatusChange) *TaskStatusChangeDelete {
+	tscd.mutation.Where(ps...)
+	return tscd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tscd *TaskStatusChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tscd.sqlExec, tscd.mutation, tscd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscd *TaskStatusChangeDelete) ExecX(ctx context.Context) int {
+	n, err := tscd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tscd *TaskStatusChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskstatuschange.Table, sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID))
+	if ps := tscd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tscd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tscd.mutation.done = true
+	return affected, err
+}
+
+// TaskStatusChangeDeleteOne is the builder for deleting a single TaskStatusChange entity.
+type TaskStatusChangeDeleteOne struct {
+	tscd *TaskStatusChangeDelete
+}
+
+// Where appends a list predicates to the TaskStatusChangeDelete builder.
+func (tscdo *TaskStatusChangeDeleteOne) Where(ps ...predicate.TaskStatusChange) *TaskStatusChangeDeleteOne {
+	tscdo.tscd.mutation.Where(ps...)
+	return tscdo
+}
+
+// Exec executes the deletion query.
+func (tscdo *TaskStatusChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := tscdo.tscd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskstatuschange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscdo *TaskStatusChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := tscdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
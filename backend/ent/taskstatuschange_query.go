@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskStatusChangeQuery is the builder for querying TaskStatusChange entities.
+type TaskStatusChangeQuery struct {
+	config
+	ctx           *QueryContext
+	order         []taskstatuschange.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.TaskStatusChange
+	withTask      *TaskQuery
+	withChangedBy *UserQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskStatusChangeQuery builder.
+func (tscq *TaskStatusChangeQuery) Where(ps ...predicate.TaskStatusChange) *TaskStatusChangeQuery {
+	tscq.predicates = append(tscq.predicates, ps...)
+	return tscq
+}
+
+// Limit the number of records to be returned by this query.
+func (tscq *TaskStatusChangeQuery) Limit(limit int) *TaskStatusChangeQuery {
+	tscq.ctx.Limit = &limit
+	return tscq
+}
+
+// Offset to start from.
+func (tscq *TaskStatusChangeQuery) Offset(offset int) *TaskStatusChangeQuery {
+	tscq.ctx.Offset = &offset
+	return tscq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tscq *TaskStatusChangeQuery) Unique(unique bool) *TaskStatusChangeQuery {
+	tscq.ctx.Unique = &unique
+	return tscq
+}
+
+// Order specifies how the records should be ordered.
+func (tscq *TaskStatusChangeQuery) Order(o ...taskstatuschange.OrderOption) *TaskStatusChangeQuery {
+	tscq.order = append(tscq.order, o...)
+	return tscq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (tscq *TaskStatusChangeQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: tscq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tscq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tscq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskstatuschange.Table, taskstatuschange.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taskstatuschange.TaskTable, taskstatuschange.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tscq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChangedBy chains the current query on the "changed_by" edge.
+func (tscq *TaskStatusChangeQuery) QueryChangedBy() *UserQuery {
+	query := (&UserClient{config: tscq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tscq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tscq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskstatuschange.Table, taskstatuschange.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, taskstatuschange.ChangedByTable, taskstatuschange.ChangedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tscq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskStatusChange entity from the query.
+// Returns a *NotFoundError when no TaskStatusChange was found.
+func (tscq *TaskStatusChangeQuery) First(ctx context.Context) (*TaskStatusChange, error) {
+	nodes, err := tscq.Limit(1).All(setContextOp(ctx, tscq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskstatuschange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) FirstX(ctx context.Context) *TaskStatusChange {
+	node, err := tscq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskStatusChange ID from the query.
+// Returns a *NotFoundError when no TaskStatusChange ID was found.
+func (tscq *TaskStatusChangeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tscq.Limit(1).IDs(setContextOp(ctx, tscq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskstatuschange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tscq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskStatusChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskStatusChange entity is found.
+// Returns a *NotFoundError when no TaskStatusChange entities are found.
+func (tscq *TaskStatusChangeQuery) Only(ctx context.Context) (*TaskStatusChange, error) {
+	nodes, err := tscq.Limit(2).All(setContextOp(ctx, tscq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskstatuschange.Label}
+	default:
+		return nil, &NotSingularError{taskstatuschange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) OnlyX(ctx context.Context) *TaskStatusChange {
+	node, err := tscq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskStatusChange ID in the query.
+// Returns a *NotSingularError when more than one TaskStatusChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tscq *TaskStatusChangeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tscq.Limit(2).IDs(setContextOp(ctx, tscq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskstatuschange.Label}
+	default:
+		err = &NotSingularError{taskstatuschange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tscq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskStatusChanges.
+func (tscq *TaskStatusChangeQuery) All(ctx context.Context) ([]*TaskStatusChange, error) {
+	ctx = setContextOp(ctx, tscq.ctx, ent.OpQueryAll)
+	if err := tscq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskStatusChange, *TaskStatusChangeQuery]()
+	return withInterceptors[[]*TaskStatusChange](ctx, tscq, qr, tscq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) AllX(ctx context.Context) []*TaskStatusChange {
+	nodes, err := tscq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskStatusChange IDs.
+func (tscq *TaskStatusChangeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tscq.ctx.Unique == nil && tscq.path != nil {
+		tscq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tscq.ctx, ent.OpQueryIDs)
+	if err = tscq.Select(taskstatuschange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tscq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tscq *TaskStatusChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tscq.ctx, ent.OpQueryCount)
+	if err := tscq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tscq, querierCount[*TaskStatusChangeQuery](), tscq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) CountX(ctx context.Context) int {
+	count, err := tscq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tscq *TaskStatusChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tscq.ctx, ent.OpQueryExist)
+	switch _, err := tscq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tscq *TaskStatusChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := tscq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskStatusChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tscq *TaskStatusChangeQuery) Clone() *TaskStatusChangeQuery {
+	if tscq == nil {
+		return nil
+	}
+	return &TaskStatusChangeQuery{
+		config:        tscq.config,
+		ctx:           tscq.ctx.Clone(),
+		order:         append([]taskstatuschange.OrderOption{}, tscq.order...),
+		inters:        append([]Interceptor{}, tscq.inters...),
+		predicates:    append([]predicate.TaskStatusChange{}, tscq.predicates...),
+		withTask:      tscq.withTask.Clone(),
+		withChangedBy: tscq.withChangedBy.Clone(),
+		// clone intermediate query.
+		sql:       tscq.sql.Clone(),
+		path:      tscq.path,
+		modifiers: append([]func(*sql.Selector){}, tscq.modifiers...),
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (tscq *TaskStatusChangeQuery) WithTask(opts ...func(*TaskQuery)) *TaskStatusChangeQuery {
+	query := (&TaskClient{config: tscq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tscq.withTask = query
+	return tscq
+}
+
+// WithChangedBy tells the query-builder to eager-load the nodes that are connected to
+// the "changed_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (tscq *TaskStatusChangeQuery) WithChangedBy(opts ...func(*UserQuery)) *TaskStatusChangeQuery {
+	query := (&UserClient{config: tscq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tscq.withChangedBy = query
+	return tscq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskStatusChange.Query().
+//		GroupBy(taskstatuschange.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tscq *TaskStatusChangeQuery) GroupBy(field string, fields ...string) *TaskStatusChangeGroupBy {
+	tscq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskStatusChangeGroupBy{build: tscq}
+	grbuild.flds = &tscq.ctx.Fields
+	grbuild.label = taskstatuschange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID uuid.UUID `json:"task_id,omitempty"`
+//	}
+//
+//	client.TaskStatusChange.Query().
+//		Select(taskstatuschange.FieldTaskID).
+//		Scan(ctx, &v)
+func (tscq *TaskStatusChangeQuery) Select(fields ...string) *TaskStatusChangeSelect {
+	tscq.ctx.Fields = append(tscq.ctx.Fields, fields...)
+	sbuild := &TaskStatusChangeSelect{TaskStatusChangeQuery: tscq}
+	sbuild.label = taskstatuschange.Label
+	sbuild.flds, sbuild.scan = &tscq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskStatusChangeSelect configured with the given aggregations.
+func (tscq *TaskStatusChangeQuery) Aggregate(fns ...AggregateFunc) *TaskStatusChangeSelect {
+	return tscq.Select().Aggregate(fns...)
+}
+
+func (tscq *TaskStatusChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tscq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tscq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tscq.ctx.Fields {
+		if !taskstatuschange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tscq.path != nil {
+		prev, err := tscq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tscq.sql = prev
+	}
+	return nil
+}
+
+func (tscq *TaskStatusChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskStatusChange, error) {
+	var (
+		nodes       = []*TaskStatusChange{}
+		_spec       = tscq.querySpec()
+		loadedTypes = [2]bool{
+			tscq.withTask != nil,
+			tscq.withChangedBy != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskStatusChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskStatusChange{config: tscq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tscq.modifiers) > 0 {
+		_spec.Modifiers = tscq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tscq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tscq.withTask; query != nil {
+		if err := tscq.loadTask(ctx, query, nodes, nil,
+			func(n *TaskStatusChange, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tscq.withChangedBy; query != nil {
+		if err := tscq.loadChangedBy(ctx, query, nodes, nil,
+			func(n *TaskStatusChange, e *User) { n.Edges.ChangedBy = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tscq *TaskStatusChangeQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*TaskStatusChange, init func(*TaskStatusChange), assign func(*TaskStatusChange, *Task)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TaskStatusChange)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tscq *TaskStatusChangeQuery) loadChangedBy(ctx context.Context, query *UserQuery, nodes []*TaskStatusChange, init func(*TaskStatusChange), assign func(*TaskStatusChange, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TaskStatusChange)
+	for i := range nodes {
+		fk := nodes[i].ChangedByID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "changed_by_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tscq *TaskStatusChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tscq.querySpec()
+	if len(tscq.modifiers) > 0 {
+		_spec.Modifiers = tscq.modifiers
+	}
+	_spec.Node.Columns = tscq.ctx.Fields
+	if len(tscq.ctx.Fields) > 0 {
+		_spec.Unique = tscq.ctx.Unique != nil && *tscq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tscq.driver, _spec)
+}
+
+func (tscq *TaskStatusChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskstatuschange.Table, taskstatuschange.Columns, sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID))
+	_spec.From = tscq.sql
+	if unique := tscq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tscq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tscq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskstatuschange.FieldID)
+		for i := range fields {
+			if fields[i] != taskstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tscq.withTask != nil {
+			_spec.Node.AddColumnOnce(taskstatuschange.FieldTaskID)
+		}
+		if tscq.withChangedBy != nil {
+			_spec.Node.AddColumnOnce(taskstatuschange.FieldChangedByID)
+		}
+	}
+	if ps := tscq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tscq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tscq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tscq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tscq *TaskStatusChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tscq.driver.Dialect())
+	t1 := builder.Table(taskstatuschange.Table)
+	columns := tscq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskstatuschange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tscq.sql != nil {
+		selector = tscq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tscq.ctx.Unique != nil && *tscq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tscq.modifiers {
+		m(selector)
+	}
+	for _, p := range tscq.predicates {
+		p(selector)
+	}
+	for _, p := range tscq.order {
+		p(selector)
+	}
+	if offset := tscq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tscq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tscq *TaskStatusChangeQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskStatusChangeSelect {
+	tscq.modifiers = append(tscq.modifiers, modifiers...)
+	return tscq.Select()
+}
+
+// TaskStatusChangeGroupBy is the group-by builder for TaskStatusChange entities.
+type TaskStatusChangeGroupBy struct {
+	selector
+	build *TaskStatusChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tscgb *TaskStatusChangeGroupBy) Aggregate(fns ...AggregateFunc) *TaskStatusChangeGroupBy {
+	tscgb.fns = append(tscgb.fns, fns...)
+	return tscgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tscgb *TaskStatusChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tscgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tscgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskStatusChangeQuery, *TaskStatusChangeGroupBy](ctx, tscgb.build, tscgb, tscgb.build.inters, v)
+}
+
+func (tscgb *TaskStatusChangeGroupBy) sqlScan(ctx context.Context, root *TaskStatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tscgb.fns))
+	for _, fn := range tscgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tscgb.flds)+len(tscgb.fns))
+		for _, f := range *tscgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tscgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tscgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskStatusChangeSelect is the builder for selecting fields of TaskStatusChange entities.
+type TaskStatusChangeSelect struct {
+	*TaskStatusChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tscs *TaskStatusChangeSelect) Aggregate(fns ...AggregateFunc) *TaskStatusChangeSelect {
+	tscs.fns = append(tscs.fns, fns...)
+	return tscs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tscs *TaskStatusChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tscs.ctx, ent.OpQuerySelect)
+	if err := tscs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskStatusChangeQuery, *TaskStatusChangeSelect](ctx, tscs.TaskStatusChangeQuery, tscs, tscs.inters, v)
+}
+
+func (tscs *TaskStatusChangeSelect) sqlScan(ctx context.Context, root *TaskStatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tscs.fns))
+	for _, fn := range tscs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tscs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tscs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tscs *TaskStatusChangeSelect) Modify(modifiers ...func(s *sql.Selector)) *TaskStatusChangeSelect {
+	tscs.modifiers = append(tscs.modifiers, modifiers...)
+	return tscs
+}
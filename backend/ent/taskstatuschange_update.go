@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/taskstatuschange"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskStatusChangeUpdate is the builder for updating TaskStatusChange entities.
+type TaskStatusChangeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskStatusChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskStatusChangeUpdate builder.
+func (tscu *TaskStatusChangeUpdate) Where(ps ...predicate.TaskStatusChange) *TaskStatusChangeUpdate {
+	tscu.mutation.Where(ps...)
+	return tscu
+}
+
+// Mutation returns the TaskStatusChangeMutation object of the builder.
+func (tscu *TaskStatusChangeUpdate) Mutation() *TaskStatusChangeMutation {
+	return tscu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tscu *TaskStatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tscu.sqlSave, tscu.mutation, tscu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscu *TaskStatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := tscu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tscu *TaskStatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := tscu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscu *TaskStatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := tscu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tscu *TaskStatusChangeUpdate) check() error {
+	if tscu.mutation.TaskCleared() && len(tscu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusChange.task"`)
+	}
+	if tscu.mutation.ChangedByCleared() && len(tscu.mutation.ChangedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusChange.changed_by"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tscu *TaskStatusChangeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskStatusChangeUpdate {
+	tscu.modifiers = append(tscu.modifiers, modifiers...)
+	return tscu
+}
+
+func (tscu *TaskStatusChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tscu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskstatuschange.Table, taskstatuschange.Columns, sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID))
+	if ps := tscu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(tscu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tscu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tscu.mutation.done = true
+	return n, nil
+}
+
+// TaskStatusChangeUpdateOne is the builder for updating a single TaskStatusChange entity.
+type TaskStatusChangeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskStatusChangeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the TaskStatusChangeMutation object of the builder.
+func (tscuo *TaskStatusChangeUpdateOne) Mutation() *TaskStatusChangeMutation {
+	return tscuo.mutation
+}
+
+// Where appends a list predicates to the TaskStatusChangeUpdate builder.
+func (tscuo *TaskStatusChangeUpdateOne) Where(ps ...predicate.TaskStatusChange) *TaskStatusChangeUpdateOne {
+	tscuo.mutation.Where(ps...)
+	return tscuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tscuo *TaskStatusChangeUpdateOne) Select(field string, fields ...string) *TaskStatusChangeUpdateOne {
+	tscuo.fields = append([]string{field}, fields...)
+	return tscuo
+}
+
+// Save executes the query and returns the updated TaskStatusChange entity.
+func (tscuo *TaskStatusChangeUpdateOne) Save(ctx context.Context) (*TaskStatusChange, error) {
+	return withHooks(ctx, tscuo.sqlSave, tscuo.mutation, tscuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tscuo *TaskStatusChangeUpdateOne) SaveX(ctx context.Context) *TaskStatusChange {
+	node, err := tscuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tscuo *TaskStatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := tscuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tscuo *TaskStatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := tscuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tscuo *TaskStatusChangeUpdateOne) check() error {
+	if tscuo.mutation.TaskCleared() && len(tscuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusChange.task"`)
+	}
+	if tscuo.mutation.ChangedByCleared() && len(tscuo.mutation.ChangedByIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskStatusChange.changed_by"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tscuo *TaskStatusChangeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskStatusChangeUpdateOne {
+	tscuo.modifiers = append(tscuo.modifiers, modifiers...)
+	return tscuo
+}
+
+func (tscuo *TaskStatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *TaskStatusChange, err error) {
+	if err := tscuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskstatuschange.Table, taskstatuschange.Columns, sqlgraph.NewFieldSpec(taskstatuschange.FieldID, field.TypeUUID))
+	id, ok := tscuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskStatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tscuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskstatuschange.FieldID)
+		for _, f := range fields {
+			if !taskstatuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tscuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(tscuo.modifiers...)
+	_node = &TaskStatusChange{config: tscuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tscuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tscuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/task"
+	"backend/ent/taskwatcher"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskWatcherCreate is the builder for creating a TaskWatcher entity.
+type TaskWatcherCreate struct {
+	config
+	mutation *TaskWatcherMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (twc *TaskWatcherCreate) SetUserID(u uuid.UUID) *TaskWatcherCreate {
+	twc.mutation.SetUserID(u)
+	return twc
+}
+
+// SetTaskID sets the "task_id" field.
+func (twc *TaskWatcherCreate) SetTaskID(u uuid.UUID) *TaskWatcherCreate {
+	twc.mutation.SetTaskID(u)
+	return twc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (twc *TaskWatcherCreate) SetCreatedAt(t time.Time) *TaskWatcherCreate {
+	twc.mutation.SetCreatedAt(t)
+	return twc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (twc *TaskWatcherCreate) SetNillableCreatedAt(t *time.Time) *TaskWatcherCreate {
+	if t != nil {
+		twc.SetCreatedAt(*t)
+	}
+	return twc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (twc *TaskWatcherCreate) SetUser(u *User) *TaskWatcherCreate {
+	return twc.SetUserID(u.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twc *TaskWatcherCreate) SetTask(t *Task) *TaskWatcherCreate {
+	return twc.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twc *TaskWatcherCreate) Mutation() *TaskWatcherMutation {
+	return twc.mutation
+}
+
+// Save creates the TaskWatcher in the database.
+func (twc *TaskWatcherCreate) Save(ctx context.Context) (*TaskWatcher, error) {
+	twc.defaults()
+	return withHooks(ctx, twc.sqlSave, twc.mutation, twc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (twc *TaskWatcherCreate) SaveX(ctx context.Context) *TaskWatcher {
+	v, err := twc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twc *TaskWatcherCreate) Exec(ctx context.Context) error {
+	_, err := twc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twc *TaskWatcherCreate) ExecX(ctx context.Context) {
+	if err := twc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (twc *TaskWatcherCreate) defaults() {
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		v := taskwatcher.DefaultCreatedAt()
+		twc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twc *TaskWatcherCreate) check() error {
+	if _, ok := twc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TaskWatcher.user_id"`)}
+	}
+	if _, ok := twc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskWatcher.task_id"`)}
+	}
+	if _, ok := twc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskWatcher.created_at"`)}
+	}
+	if len(twc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TaskWatcher.user"`)}
+	}
+	if len(twc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "TaskWatcher.task"`)}
+	}
+	return nil
+}
+
+func (twc *TaskWatcherCreate) sqlSave(ctx context.Context) (*TaskWatcher, error) {
+	if err := twc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := twc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, twc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	twc.mutation.id = &_node.ID
+	twc.mutation.done = true
+	return _node, nil
+}
+
+func (twc *TaskWatcherCreate) createSpec() (*TaskWatcher, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskWatcher{config: twc.config}
+		_spec = sqlgraph.NewCreateSpec(taskwatcher.Table, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	)
+	if value, ok := twc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskwatcher.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := twc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := twc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TaskWatcherCreateBulk is the builder for creating many TaskWatcher entities in bulk.
+type TaskWatcherCreateBulk struct {
+	config
+	err      error
+	builders []*TaskWatcherCreate
+}
+
+// Save creates the TaskWatcher entities in the database.
+func (twcb *TaskWatcherCreateBulk) Save(ctx context.Context) ([]*TaskWatcher, error) {
+	if twcb.err != nil {
+		return nil, twcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(twcb.builders))
+	nodes := make([]*TaskWatcher, len(twcb.builders))
+	mutators := make([]Mutator, len(twcb.builders))
+	for i := range twcb.builders {
+		func(i int, root context.Context) {
+			builder := twcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskWatcherMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, twcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, twcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, twcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twcb *TaskWatcherCreateBulk) SaveX(ctx context.Context) []*TaskWatcher {
+	v, err := twcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (twcb *TaskWatcherCreateBulk) Exec(ctx context.Context) error {
+	_, err := twcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twcb *TaskWatcherCreateBulk) ExecX(ctx context.Context) {
+	if err := twcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,705 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/task"
+	"backend/ent/taskwatcher"
+	"backend/ent/user"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskWatcherQuery is the builder for querying TaskWatcher entities.
+type TaskWatcherQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskwatcher.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskWatcher
+	withUser   *UserQuery
+	withTask   *TaskQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskWatcherQuery builder.
+func (twq *TaskWatcherQuery) Where(ps ...predicate.TaskWatcher) *TaskWatcherQuery {
+	twq.predicates = append(twq.predicates, ps...)
+	return twq
+}
+
+// Limit the number of records to be returned by this query.
+func (twq *TaskWatcherQuery) Limit(limit int) *TaskWatcherQuery {
+	twq.ctx.Limit = &limit
+	return twq
+}
+
+// Offset to start from.
+func (twq *TaskWatcherQuery) Offset(offset int) *TaskWatcherQuery {
+	twq.ctx.Offset = &offset
+	return twq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (twq *TaskWatcherQuery) Unique(unique bool) *TaskWatcherQuery {
+	twq.ctx.Unique = &unique
+	return twq
+}
+
+// Order specifies how the records should be ordered.
+func (twq *TaskWatcherQuery) Order(o ...taskwatcher.OrderOption) *TaskWatcherQuery {
+	twq.order = append(twq.order, o...)
+	return twq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (twq *TaskWatcherQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: twq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := twq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := twq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskwatcher.Table, taskwatcher.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, taskwatcher.UserTable, taskwatcher.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(twq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (twq *TaskWatcherQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: twq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := twq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := twq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taskwatcher.Table, taskwatcher.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, taskwatcher.TaskTable, taskwatcher.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(twq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TaskWatcher entity from the query.
+// Returns a *NotFoundError when no TaskWatcher was found.
+func (twq *TaskWatcherQuery) First(ctx context.Context) (*TaskWatcher, error) {
+	nodes, err := twq.Limit(1).All(setContextOp(ctx, twq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskwatcher.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (twq *TaskWatcherQuery) FirstX(ctx context.Context) *TaskWatcher {
+	node, err := twq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskWatcher ID from the query.
+// Returns a *NotFoundError when no TaskWatcher ID was found.
+func (twq *TaskWatcherQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = twq.Limit(1).IDs(setContextOp(ctx, twq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskwatcher.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (twq *TaskWatcherQuery) FirstIDX(ctx context.Context) int {
+	id, err := twq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskWatcher entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskWatcher entity is found.
+// Returns a *NotFoundError when no TaskWatcher entities are found.
+func (twq *TaskWatcherQuery) Only(ctx context.Context) (*TaskWatcher, error) {
+	nodes, err := twq.Limit(2).All(setContextOp(ctx, twq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskwatcher.Label}
+	default:
+		return nil, &NotSingularError{taskwatcher.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (twq *TaskWatcherQuery) OnlyX(ctx context.Context) *TaskWatcher {
+	node, err := twq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskWatcher ID in the query.
+// Returns a *NotSingularError when more than one TaskWatcher ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (twq *TaskWatcherQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = twq.Limit(2).IDs(setContextOp(ctx, twq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskwatcher.Label}
+	default:
+		err = &NotSingularError{taskwatcher.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (twq *TaskWatcherQuery) OnlyIDX(ctx context.Context) int {
+	id, err := twq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskWatchers.
+func (twq *TaskWatcherQuery) All(ctx context.Context) ([]*TaskWatcher, error) {
+	ctx = setContextOp(ctx, twq.ctx, ent.OpQueryAll)
+	if err := twq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskWatcher, *TaskWatcherQuery]()
+	return withInterceptors[[]*TaskWatcher](ctx, twq, qr, twq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (twq *TaskWatcherQuery) AllX(ctx context.Context) []*TaskWatcher {
+	nodes, err := twq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskWatcher IDs.
+func (twq *TaskWatcherQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if twq.ctx.Unique == nil && twq.path != nil {
+		twq.Unique(true)
+	}
+	ctx = setContextOp(ctx, twq.ctx, ent.OpQueryIDs)
+	if err = twq.Select(taskwatcher.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (twq *TaskWatcherQuery) IDsX(ctx context.Context) []int {
+	ids, err := twq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (twq *TaskWatcherQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, twq.ctx, ent.OpQueryCount)
+	if err := twq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, twq, querierCount[*TaskWatcherQuery](), twq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (twq *TaskWatcherQuery) CountX(ctx context.Context) int {
+	count, err := twq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (twq *TaskWatcherQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, twq.ctx, ent.OpQueryExist)
+	switch _, err := twq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (twq *TaskWatcherQuery) ExistX(ctx context.Context) bool {
+	exist, err := twq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskWatcherQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (twq *TaskWatcherQuery) Clone() *TaskWatcherQuery {
+	if twq == nil {
+		return nil
+	}
+	return &TaskWatcherQuery{
+		config:     twq.config,
+		ctx:        twq.ctx.Clone(),
+		order:      append([]taskwatcher.OrderOption{}, twq.order...),
+		inters:     append([]Interceptor{}, twq.inters...),
+		predicates: append([]predicate.TaskWatcher{}, twq.predicates...),
+		withUser:   twq.withUser.Clone(),
+		withTask:   twq.withTask.Clone(),
+		// clone intermediate query.
+		sql:       twq.sql.Clone(),
+		path:      twq.path,
+		modifiers: append([]func(*sql.Selector){}, twq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (twq *TaskWatcherQuery) WithUser(opts ...func(*UserQuery)) *TaskWatcherQuery {
+	query := (&UserClient{config: twq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	twq.withUser = query
+	return twq
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (twq *TaskWatcherQuery) WithTask(opts ...func(*TaskQuery)) *TaskWatcherQuery {
+	query := (&TaskClient{config: twq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	twq.withTask = query
+	return twq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskWatcher.Query().
+//		GroupBy(taskwatcher.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (twq *TaskWatcherQuery) GroupBy(field string, fields ...string) *TaskWatcherGroupBy {
+	twq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskWatcherGroupBy{build: twq}
+	grbuild.flds = &twq.ctx.Fields
+	grbuild.label = taskwatcher.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID uuid.UUID `json:"user_id,omitempty"`
+//	}
+//
+//	client.TaskWatcher.Query().
+//		Select(taskwatcher.FieldUserID).
+//		Scan(ctx, &v)
+func (twq *TaskWatcherQuery) Select(fields ...string) *TaskWatcherSelect {
+	twq.ctx.Fields = append(twq.ctx.Fields, fields...)
+	sbuild := &TaskWatcherSelect{TaskWatcherQuery: twq}
+	sbuild.label = taskwatcher.Label
+	sbuild.flds, sbuild.scan = &twq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskWatcherSelect configured with the given aggregations.
+func (twq *TaskWatcherQuery) Aggregate(fns ...AggregateFunc) *TaskWatcherSelect {
+	return twq.Select().Aggregate(fns...)
+}
+
+func (twq *TaskWatcherQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range twq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, twq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range twq.ctx.Fields {
+		if !taskwatcher.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if twq.path != nil {
+		prev, err := twq.path(ctx)
+		if err != nil {
+			return err
+		}
+		twq.sql = prev
+	}
+	return nil
+}
+
+func (twq *TaskWatcherQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskWatcher, error) {
+	var (
+		nodes       = []*TaskWatcher{}
+		_spec       = twq.querySpec()
+		loadedTypes = [2]bool{
+			twq.withUser != nil,
+			twq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskWatcher).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskWatcher{config: twq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(twq.modifiers) > 0 {
+		_spec.Modifiers = twq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, twq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := twq.withUser; query != nil {
+		if err := twq.loadUser(ctx, query, nodes, nil,
+			func(n *TaskWatcher, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := twq.withTask; query != nil {
+		if err := twq.loadTask(ctx, query, nodes, nil,
+			func(n *TaskWatcher, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (twq *TaskWatcherQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*TaskWatcher, init func(*TaskWatcher), assign func(*TaskWatcher, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TaskWatcher)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (twq *TaskWatcherQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*TaskWatcher, init func(*TaskWatcher), assign func(*TaskWatcher, *Task)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TaskWatcher)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (twq *TaskWatcherQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := twq.querySpec()
+	if len(twq.modifiers) > 0 {
+		_spec.Modifiers = twq.modifiers
+	}
+	_spec.Node.Columns = twq.ctx.Fields
+	if len(twq.ctx.Fields) > 0 {
+		_spec.Unique = twq.ctx.Unique != nil && *twq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, twq.driver, _spec)
+}
+
+func (twq *TaskWatcherQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	_spec.From = twq.sql
+	if unique := twq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if twq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := twq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskwatcher.FieldID)
+		for i := range fields {
+			if fields[i] != taskwatcher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if twq.withUser != nil {
+			_spec.Node.AddColumnOnce(taskwatcher.FieldUserID)
+		}
+		if twq.withTask != nil {
+			_spec.Node.AddColumnOnce(taskwatcher.FieldTaskID)
+		}
+	}
+	if ps := twq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := twq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := twq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := twq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (twq *TaskWatcherQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(twq.driver.Dialect())
+	t1 := builder.Table(taskwatcher.Table)
+	columns := twq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskwatcher.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if twq.sql != nil {
+		selector = twq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if twq.ctx.Unique != nil && *twq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range twq.modifiers {
+		m(selector)
+	}
+	for _, p := range twq.predicates {
+		p(selector)
+	}
+	for _, p := range twq.order {
+		p(selector)
+	}
+	if offset := twq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := twq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (twq *TaskWatcherQuery) Modify(modifiers ...func(s *sql.Selector)) *TaskWatcherSelect {
+	twq.modifiers = append(twq.modifiers, modifiers...)
+	return twq.Select()
+}
+
+// TaskWatcherGroupBy is the group-by builder for TaskWatcher entities.
+type TaskWatcherGroupBy struct {
+	selector
+	build *TaskWatcherQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (twgb *TaskWatcherGroupBy) Aggregate(fns ...AggregateFunc) *TaskWatcherGroupBy {
+	twgb.fns = append(twgb.fns, fns...)
+	return twgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (twgb *TaskWatcherGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, twgb.build.ctx, ent.OpQueryGroupBy)
+	if err := twgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskWatcherQuery, *TaskWatcherGroupBy](ctx, twgb.build, twgb, twgb.build.inters, v)
+}
+
+func (twgb *TaskWatcherGroupBy) sqlScan(ctx context.Context, root *TaskWatcherQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(twgb.fns))
+	for _, fn := range twgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*twgb.flds)+len(twgb.fns))
+		for _, f := range *twgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*twgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := twgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskWatcherSelect is the builder for selecting fields of TaskWatcher entities.
+type TaskWatcherSelect struct {
+	*TaskWatcherQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tws *TaskWatcherSelect) Aggregate(fns ...AggregateFunc) *TaskWatcherSelect {
+	tws.fns = append(tws.fns, fns...)
+	return tws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tws *TaskWatcherSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tws.ctx, ent.OpQuerySelect)
+	if err := tws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskWatcherQuery, *TaskWatcherSelect](ctx, tws.TaskWatcherQuery, tws, tws.inters, v)
+}
+
+func (tws *TaskWatcherSelect) sqlScan(ctx context.Context, root *TaskWatcherQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tws.fns))
+	for _, fn := range tws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tws *TaskWatcherSelect) Modify(modifiers ...func(s *sql.Selector)) *TaskWatcherSelect {
+	tws.modifiers = append(tws.modifiers, modifiers...)
+	return tws
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/task"
+	"backend/ent/taskwatcher"
+	"backend/ent/user"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TaskWatcherUpdate is the builder for updating TaskWatcher entities.
+type TaskWatcherUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskWatcherMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (twu *TaskWatcherUpdate) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdate {
+	twu.mutation.Where(ps...)
+	return twu
+}
+
+// SetUserID sets the "user_id" field.
+func (twu *TaskWatcherUpdate) SetUserID(u uuid.UUID) *TaskWatcherUpdate {
+	twu.mutation.SetUserID(u)
+	return twu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (twu *TaskWatcherUpdate) SetNillableUserID(u *uuid.UUID) *TaskWatcherUpdate {
+	if u != nil {
+		twu.SetUserID(*u)
+	}
+	return twu
+}
+
+// SetTaskID sets the "task_id" field.
+func (twu *TaskWatcherUpdate) SetTaskID(u uuid.UUID) *TaskWatcherUpdate {
+	twu.mutation.SetTaskID(u)
+	return twu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (twu *TaskWatcherUpdate) SetNillableTaskID(u *uuid.UUID) *TaskWatcherUpdate {
+	if u != nil {
+		twu.SetTaskID(*u)
+	}
+	return twu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (twu *TaskWatcherUpdate) SetUser(u *User) *TaskWatcherUpdate {
+	return twu.SetUserID(u.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twu *TaskWatcherUpdate) SetTask(t *Task) *TaskWatcherUpdate {
+	return twu.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twu *TaskWatcherUpdate) Mutation() *TaskWatcherMutation {
+	return twu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (twu *TaskWatcherUpdate) ClearUser() *TaskWatcherUpdate {
+	twu.mutation.ClearUser()
+	return twu
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (twu *TaskWatcherUpdate) ClearTask() *TaskWatcherUpdate {
+	twu.mutation.ClearTask()
+	return twu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (twu *TaskWatcherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, twu.sqlSave, twu.mutation, twu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twu *TaskWatcherUpdate) SaveX(ctx context.Context) int {
+	affected, err := twu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (twu *TaskWatcherUpdate) Exec(ctx context.Context) error {
+	_, err := twu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twu *TaskWatcherUpdate) ExecX(ctx context.Context) {
+	if err := twu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twu *TaskWatcherUpdate) check() error {
+	if twu.mutation.UserCleared() && len(twu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.user"`)
+	}
+	if twu.mutation.TaskCleared() && len(twu.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (twu *TaskWatcherUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWatcherUpdate {
+	twu.modifiers = append(twu.modifiers, modifiers...)
+	return twu
+}
+
+func (twu *TaskWatcherUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := twu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	if ps := twu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if twu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if twu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(twu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, twu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	twu.mutation.done = true
+	return n, nil
+}
+
+// TaskWatcherUpdateOne is the builder for updating a single TaskWatcher entity.
+type TaskWatcherUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskWatcherMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (twuo *TaskWatcherUpdateOne) SetUserID(u uuid.UUID) *TaskWatcherUpdateOne {
+	twuo.mutation.SetUserID(u)
+	return twuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (twuo *TaskWatcherUpdateOne) SetNillableUserID(u *uuid.UUID) *TaskWatcherUpdateOne {
+	if u != nil {
+		twuo.SetUserID(*u)
+	}
+	return twuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (twuo *TaskWatcherUpdateOne) SetTaskID(u uuid.UUID) *TaskWatcherUpdateOne {
+	twuo.mutation.SetTaskID(u)
+	return twuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (twuo *TaskWatcherUpdateOne) SetNillableTaskID(u *uuid.UUID) *TaskWatcherUpdateOne {
+	if u != nil {
+		twuo.SetTaskID(*u)
+	}
+	return twuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (twuo *TaskWatcherUpdateOne) SetUser(u *User) *TaskWatcherUpdateOne {
+	return twuo.SetUserID(u.ID)
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (twuo *TaskWatcherUpdateOne) SetTask(t *Task) *TaskWatcherUpdateOne {
+	return twuo.SetTaskID(t.ID)
+}
+
+// Mutation returns the TaskWatcherMutation object of the builder.
+func (twuo *TaskWatcherUpdateOne) Mutation() *TaskWatcherMutation {
+	return twuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (twuo *TaskWatcherUpdateOne) ClearUser() *TaskWatcherUpdateOne {
+	twuo.mutation.ClearUser()
+	return twuo
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (twuo *TaskWatcherUpdateOne) ClearTask() *TaskWatcherUpdateOne {
+	twuo.mutation.ClearTask()
+	return twuo
+}
+
+// Where appends a list predicates to the TaskWatcherUpdate builder.
+func (twuo *TaskWatcherUpdateOne) Where(ps ...predicate.TaskWatcher) *TaskWatcherUpdateOne {
+	twuo.mutation.Where(ps...)
+	return twuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (twuo *TaskWatcherUpdateOne) Select(field string, fields ...string) *TaskWatcherUpdateOne {
+	twuo.fields = append([]string{field}, fields...)
+	return twuo
+}
+
+// Save executes the query and returns the updated TaskWatcher entity.
+func (twuo *TaskWatcherUpdateOne) Save(ctx context.Context) (*TaskWatcher, error) {
+	return withHooks(ctx, twuo.sqlSave, twuo.mutation, twuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (twuo *TaskWatcherUpdateOne) SaveX(ctx context.Context) *TaskWatcher {
+	node, err := twuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (twuo *TaskWatcherUpdateOne) Exec(ctx context.Context) error {
+	_, err := twuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (twuo *TaskWatcherUpdateOne) ExecX(ctx context.Context) {
+	if err := twuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (twuo *TaskWatcherUpdateOne) check() error {
+	if twuo.mutation.UserCleared() && len(twuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.user"`)
+	}
+	if twuo.mutation.TaskCleared() && len(twuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TaskWatcher.task"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (twuo *TaskWatcherUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskWatcherUpdateOne {
+	twuo.modifiers = append(twuo.modifiers, modifiers...)
+	return twuo
+}
+
+func (twuo *TaskWatcherUpdateOne) sqlSave(ctx context.Context) (_node *TaskWatcher, err error) {
+	if err := twuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskwatcher.Table, taskwatcher.Columns, sqlgraph.NewFieldSpec(taskwatcher.FieldID, field.TypeInt))
+	id, ok := twuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskWatcher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := twuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskwatcher.FieldID)
+		for _, f := range fields {
+			if !taskwatcher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskwatcher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := twuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if twuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.UserTable,
+			Columns: []string{taskwatcher.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if twuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := twuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   taskwatcher.TaskTable,
+			Columns: []string{taskwatcher.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(twuo.modifiers...)
+	_node = &TaskWatcher{config: twuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, twuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskwatcher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	twuo.mutation.done = true
+	return _node, nil
+}
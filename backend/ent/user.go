@@ -26,10 +26,16 @@ type User struct {
 	PasswordHash string `json:"-"`
 	// DisplayName holds the value of the "display_name" field.
 	DisplayName string `json:"display_name,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
 	// LastOrgID holds the value of the "last_org_id" field.
 	LastOrgID *uuid.UUID `json:"last_org_id,omitempty"`
 	// LastProjectID holds the value of the "last_project_id" field.
 	LastProjectID *uuid.UUID `json:"last_project_id,omitempty"`
+	// PasswordChangedAt holds the value of the "password_changed_at" field.
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -46,6 +52,10 @@ type UserEdges struct {
 	Organizations []*Organization `json:"organizations,omitempty"`
 	// Projects holds the value of the projects edge.
 	Projects []*Project `json:"projects,omitempty"`
+	// WatchedTasks holds the value of the watched_tasks edge.
+	WatchedTasks []*Task `json:"watched_tasks,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
 	// SentInvites holds the value of the sent_invites edge.
 	SentInvites []*Invite `json:"sent_invites,omitempty"`
 	// LastOrganization holds the value of the last_organization edge.
@@ -56,9 +66,11 @@ type UserEdges struct {
 	OrganizationMemberships []*OrganizationMember `json:"organization_memberships,omitempty"`
 	// ProjectMemberships holds the value of the project_memberships edge.
 	ProjectMemberships []*ProjectMember `json:"project_memberships,omitempty"`
+	// TaskWatchers holds the value of the task_watchers edge.
+	TaskWatchers []*TaskWatcher `json:"task_watchers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [10]bool
 }
 
 // OrganizationsOrErr returns the Organizations value or an error if the edge
@@ -79,10 +91,28 @@ func (e UserEdges) ProjectsOrErr() ([]*Project, error) {
 	return nil, &NotLoadedError{edge: "projects"}
 }
 
+// WatchedTasksOrErr returns the WatchedTasks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WatchedTasksOrErr() ([]*Task, error) {
+	if e.loadedTypes[2] {
+		return e.WatchedTasks, nil
+	}
+	return nil, &NotLoadedError{edge: "watched_tasks"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[3] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
 // SentInvitesOrErr returns the SentInvites value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) SentInvitesOrErr() ([]*Invite, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.SentInvites, nil
 	}
 	return nil, &NotLoadedError{edge: "sent_invites"}
@@ -93,7 +123,7 @@ func (e UserEdges) SentInvitesOrErr() ([]*Invite, error) {
 func (e UserEdges) LastOrganizationOrErr() (*Organization, error) {
 	if e.LastOrganization != nil {
 		return e.LastOrganization, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: organization.Label}
 	}
 	return nil, &NotLoadedError{edge: "last_organization"}
@@ -104,7 +134,7 @@ func (e UserEdges) LastOrganizationOrErr() (*Organization, error) {
 func (e UserEdges) LastProjectOrErr() (*Project, error) {
 	if e.LastProject != nil {
 		return e.LastProject, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: project.Label}
 	}
 	return nil, &NotLoadedError{edge: "last_project"}
@@ -113,7 +143,7 @@ func (e UserEdges) LastProjectOrErr() (*Project, error) {
 // OrganizationMembershipsOrErr returns the OrganizationMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[7] {
 		return e.OrganizationMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "organization_memberships"}
@@ -122,12 +152,21 @@ func (e UserEdges) OrganizationMembershipsOrErr() ([]*OrganizationMember, error)
 // ProjectMembershipsOrErr returns the ProjectMemberships value or an error if the edge
 // was not loaded in eager-loading.
 func (e UserEdges) ProjectMembershipsOrErr() ([]*ProjectMember, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[8] {
 		return e.ProjectMemberships, nil
 	}
 	return nil, &NotLoadedError{edge: "project_memberships"}
 }
 
+// TaskWatchersOrErr returns the TaskWatchers value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TaskWatchersOrErr() ([]*TaskWatcher, error) {
+	if e.loadedTypes[9] {
+		return e.TaskWatchers, nil
+	}
+	return nil, &NotLoadedError{edge: "task_watchers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -135,9 +174,9 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldLastOrgID, user.FieldLastProjectID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case user.FieldEmail, user.FieldPasswordHash, user.FieldDisplayName:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldDisplayName, user.FieldTimezone:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldPasswordChangedAt, user.FieldDeletedAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -180,6 +219,12 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.DisplayName = value.String
 			}
+		case user.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				u.Timezone = value.String
+			}
 		case user.FieldLastOrgID:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field last_org_id", values[i])
@@ -194,6 +239,20 @@ func (u *User) assignValues(columns []string, values []any) error {
 				u.LastProjectID = new(uuid.UUID)
 				*u.LastProjectID = *value.S.(*uuid.UUID)
 			}
+		case user.FieldPasswordChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field password_changed_at", values[i])
+			} else if value.Valid {
+				u.PasswordChangedAt = new(time.Time)
+				*u.PasswordChangedAt = value.Time
+			}
+		case user.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				u.DeletedAt = new(time.Time)
+				*u.DeletedAt = value.Time
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -229,6 +288,16 @@ func (u *User) QueryProjects() *ProjectQuery {
 	return NewUserClient(u.config).QueryProjects(u)
 }
 
+// QueryWatchedTasks queries the "watched_tasks" edge of the User entity.
+func (u *User) QueryWatchedTasks() *TaskQuery {
+	return NewUserClient(u.config).QueryWatchedTasks(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
 // QuerySentInvites queries the "sent_invites" edge of the User entity.
 func (u *User) QuerySentInvites() *InviteQuery {
 	return NewUserClient(u.config).QuerySentInvites(u)
@@ -254,6 +323,11 @@ func (u *User) QueryProjectMemberships() *ProjectMemberQuery {
 	return NewUserClient(u.config).QueryProjectMemberships(u)
 }
 
+// QueryTaskWatchers queries the "task_watchers" edge of the User entity.
+func (u *User) QueryTaskWatchers() *TaskWatcherQuery {
+	return NewUserClient(u.config).QueryTaskWatchers(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -285,6 +359,9 @@ func (u *User) String() string {
 	builder.WriteString("display_name=")
 	builder.WriteString(u.DisplayName)
 	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(u.Timezone)
+	builder.WriteString(", ")
 	if v := u.LastOrgID; v != nil {
 		builder.WriteString("last_org_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -295,6 +372,16 @@ func (u *User) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := u.PasswordChangedAt; v != nil {
+		builder.WriteString("password_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := u.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -21,10 +21,16 @@ const (
 	FieldPasswordHash = "password_hash"
 	// FieldDisplayName holds the string denoting the display_name field in the database.
 	FieldDisplayName = "display_name"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldLastOrgID holds the string denoting the last_org_id field in the database.
 	FieldLastOrgID = "last_org_id"
 	// FieldLastProjectID holds the string denoting the last_project_id field in the database.
 	FieldLastProjectID = "last_project_id"
+	// FieldPasswordChangedAt holds the string denoting the password_changed_at field in the database.
+	FieldPasswordChangedAt = "password_changed_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -33,6 +39,10 @@ const (
 	EdgeOrganizations = "organizations"
 	// EdgeProjects holds the string denoting the projects edge name in mutations.
 	EdgeProjects = "projects"
+	// EdgeWatchedTasks holds the string denoting the watched_tasks edge name in mutations.
+	EdgeWatchedTasks = "watched_tasks"
+	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
+	EdgeNotifications = "notifications"
 	// EdgeSentInvites holds the string denoting the sent_invites edge name in mutations.
 	EdgeSentInvites = "sent_invites"
 	// EdgeLastOrganization holds the string denoting the last_organization edge name in mutations.
@@ -43,6 +53,8 @@ const (
 	EdgeOrganizationMemberships = "organization_memberships"
 	// EdgeProjectMemberships holds the string denoting the project_memberships edge name in mutations.
 	EdgeProjectMemberships = "project_memberships"
+	// EdgeTaskWatchers holds the string denoting the task_watchers edge name in mutations.
+	EdgeTaskWatchers = "task_watchers"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// OrganizationsTable is the table that holds the organizations relation/edge. The primary key declared below.
@@ -55,6 +67,18 @@ const (
 	// ProjectsInverseTable is the table name for the Project entity.
 	// It exists in this package in order to avoid circular dependency with the "project" package.
 	ProjectsInverseTable = "projects"
+	// WatchedTasksTable is the table that holds the watched_tasks relation/edge. The primary key declared below.
+	WatchedTasksTable = "task_watchers"
+	// WatchedTasksInverseTable is the table name for the Task entity.
+	// It exists in this package in order to avoid circular dependency with the "task" package.
+	WatchedTasksInverseTable = "tasks"
+	// NotificationsTable is the table that holds the notifications relation/edge.
+	NotificationsTable = "notifications"
+	// NotificationsInverseTable is the table name for the Notification entity.
+	// It exists in this package in order to avoid circular dependency with the "notification" package.
+	NotificationsInverseTable = "notifications"
+	// NotificationsColumn is the table column denoting the notifications relation/edge.
+	NotificationsColumn = "user_id"
 	// SentInvitesTable is the table that holds the sent_invites relation/edge.
 	SentInvitesTable = "invites"
 	// SentInvitesInverseTable is the table name for the Invite entity.
@@ -90,6 +114,13 @@ const (
 	ProjectMembershipsInverseTable = "project_members"
 	// ProjectMembershipsColumn is the table column denoting the project_memberships relation/edge.
 	ProjectMembershipsColumn = "user_id"
+	// TaskWatchersTable is the table that holds the task_watchers relation/edge.
+	TaskWatchersTable = "task_watchers"
+	// TaskWatchersInverseTable is the table name for the TaskWatcher entity.
+	// It exists in this package in order to avoid circular dependency with the "taskwatcher" package.
+	TaskWatchersInverseTable = "task_watchers"
+	// TaskWatchersColumn is the table column denoting the task_watchers relation/edge.
+	TaskWatchersColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -98,8 +129,11 @@ var Columns = []string{
 	FieldEmail,
 	FieldPasswordHash,
 	FieldDisplayName,
+	FieldTimezone,
 	FieldLastOrgID,
 	FieldLastProjectID,
+	FieldPasswordChangedAt,
+	FieldDeletedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -111,6 +145,9 @@ var (
 	// ProjectsPrimaryKey and ProjectsColumn2 are the table columns denoting the
 	// primary key for the projects relation (M2M).
 	ProjectsPrimaryKey = []string{"user_id", "project_id"}
+	// WatchedTasksPrimaryKey and WatchedTasksColumn2 are the table columns denoting the
+	// primary key for the watched_tasks relation (M2M).
+	WatchedTasksPrimaryKey = []string{"user_id", "task_id"}
 )
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -130,6 +167,10 @@ var (
 	PasswordHashValidator func(string) error
 	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	DisplayNameValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -163,6 +204,11 @@ func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
 }
 
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByLastOrgID orders the results by the last_org_id field.
 func ByLastOrgID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastOrgID, opts...).ToFunc()
@@ -173,6 +219,16 @@ func ByLastProjectID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastProjectID, opts...).ToFunc()
 }
 
+// ByPasswordChangedAt orders the results by the password_changed_at field.
+func ByPasswordChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordChangedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -211,6 +267,34 @@ func ByProjects(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByWatchedTasksCount orders the results by watched_tasks count.
+func ByWatchedTasksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchedTasksStep(), opts...)
+	}
+}
+
+// ByWatchedTasks orders the results by watched_tasks terms.
+func ByWatchedTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchedTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotificationsCount orders the results by notifications count.
+func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotificationsStep(), opts...)
+	}
+}
+
+// ByNotifications orders the results by notifications terms.
+func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotificationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // BySentInvitesCount orders the results by sent_invites count.
 func BySentInvitesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -266,6 +350,20 @@ func ByProjectMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newProjectMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTaskWatchersCount orders the results by task_watchers count.
+func ByTaskWatchersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTaskWatchersStep(), opts...)
+	}
+}
+
+// ByTaskWatchers orders the results by task_watchers terms.
+func ByTaskWatchers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskWatchersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrganizationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -280,6 +378,20 @@ func newProjectsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, ProjectsTable, ProjectsPrimaryKey...),
 	)
 }
+func newWatchedTasksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchedTasksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, WatchedTasksTable, WatchedTasksPrimaryKey...),
+	)
+}
+func newNotificationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotificationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+	)
+}
 func newSentInvitesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -315,3 +427,10 @@ func newProjectMembershipsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, ProjectMembershipsTable, ProjectMembershipsColumn),
 	)
 }
+func newTaskWatchersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskWatchersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, TaskWatchersTable, TaskWatchersColumn),
+	)
+}
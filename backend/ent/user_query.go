@@ -4,11 +4,14 @@ package ent
 
 import (
 	"backend/ent/invite"
+	"backend/ent/notification"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/predicate"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/task"
+	"backend/ent/taskwatcher"
 	"backend/ent/user"
 	"context"
 	"database/sql/driver"
@@ -31,11 +34,15 @@ type UserQuery struct {
 	predicates                  []predicate.User
 	withOrganizations           *OrganizationQuery
 	withProjects                *ProjectQuery
+	withWatchedTasks            *TaskQuery
+	withNotifications           *NotificationQuery
 	withSentInvites             *InviteQuery
 	withLastOrganization        *OrganizationQuery
 	withLastProject             *ProjectQuery
 	withOrganizationMemberships *OrganizationMemberQuery
 	withProjectMemberships      *ProjectMemberQuery
+	withTaskWatchers            *TaskWatcherQuery
+	modifiers                   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -116,6 +123,50 @@ func (uq *UserQuery) QueryProjects() *ProjectQuery {
 	return query
 }
 
+// QueryWatchedTasks chains the current query on the "watched_tasks" edge.
+func (uq *UserQuery) QueryWatchedTasks() *TaskQuery {
+	query := (&TaskClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, user.WatchedTasksTable, user.WatchedTasksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotifications chains the current query on the "notifications" edge.
+func (uq *UserQuery) QueryNotifications() *NotificationQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationsTable, user.NotificationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySentInvites chains the current query on the "sent_invites" edge.
 func (uq *UserQuery) QuerySentInvites() *InviteQuery {
 	query := (&InviteClient{config: uq.config}).Query()
@@ -226,6 +277,28 @@ func (uq *UserQuery) QueryProjectMemberships() *ProjectMemberQuery {
 	return query
 }
 
+// QueryTaskWatchers chains the current query on the "task_watchers" edge.
+func (uq *UserQuery) QueryTaskWatchers() *TaskWatcherQuery {
+	query := (&TaskWatcherClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(taskwatcher.Table, taskwatcher.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.TaskWatchersTable, user.TaskWatchersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -420,14 +493,18 @@ func (uq *UserQuery) Clone() *UserQuery {
 		predicates:                  append([]predicate.User{}, uq.predicates...),
 		withOrganizations:           uq.withOrganizations.Clone(),
 		withProjects:                uq.withProjects.Clone(),
+		withWatchedTasks:            uq.withWatchedTasks.Clone(),
+		withNotifications:           uq.withNotifications.Clone(),
 		withSentInvites:             uq.withSentInvites.Clone(),
 		withLastOrganization:        uq.withLastOrganization.Clone(),
 		withLastProject:             uq.withLastProject.Clone(),
 		withOrganizationMemberships: uq.withOrganizationMemberships.Clone(),
 		withProjectMemberships:      uq.withProjectMemberships.Clone(),
+		withTaskWatchers:            uq.withTaskWatchers.Clone(),
 		// clone intermediate query.
-		sql:  uq.sql.Clone(),
-		path: uq.path,
+		sql:       uq.sql.Clone(),
+		path:      uq.path,
+		modifiers: append([]func(*sql.Selector){}, uq.modifiers...),
 	}
 }
 
@@ -453,6 +530,28 @@ func (uq *UserQuery) WithProjects(opts ...func(*ProjectQuery)) *UserQuery {
 	return uq
 }
 
+// WithWatchedTasks tells the query-builder to eager-load the nodes that are connected to
+// the "watched_tasks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithWatchedTasks(opts ...func(*TaskQuery)) *UserQuery {
+	query := (&TaskClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withWatchedTasks = query
+	return uq
+}
+
+// WithNotifications tells the query-builder to eager-load the nodes that are connected to
+// the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithNotifications(opts ...func(*NotificationQuery)) *UserQuery {
+	query := (&NotificationClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withNotifications = query
+	return uq
+}
+
 // WithSentInvites tells the query-builder to eager-load the nodes that are connected to
 // the "sent_invites" edge. The optional arguments are used to configure the query builder of the edge.
 func (uq *UserQuery) WithSentInvites(opts ...func(*InviteQuery)) *UserQuery {
@@ -508,6 +607,17 @@ func (uq *UserQuery) WithProjectMemberships(opts ...func(*ProjectMemberQuery)) *
 	return uq
 }
 
+// WithTaskWatchers tells the query-builder to eager-load the nodes that are connected to
+// the "task_watchers" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTaskWatchers(opts ...func(*TaskWatcherQuery)) *UserQuery {
+	query := (&TaskWatcherClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTaskWatchers = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -586,14 +696,17 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [10]bool{
 			uq.withOrganizations != nil,
 			uq.withProjects != nil,
+			uq.withWatchedTasks != nil,
+			uq.withNotifications != nil,
 			uq.withSentInvites != nil,
 			uq.withLastOrganization != nil,
 			uq.withLastProject != nil,
 			uq.withOrganizationMemberships != nil,
 			uq.withProjectMemberships != nil,
+			uq.withTaskWatchers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -605,6 +718,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -628,6 +744,20 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withWatchedTasks; query != nil {
+		if err := uq.loadWatchedTasks(ctx, query, nodes,
+			func(n *User) { n.Edges.WatchedTasks = []*Task{} },
+			func(n *User, e *Task) { n.Edges.WatchedTasks = append(n.Edges.WatchedTasks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withNotifications; query != nil {
+		if err := uq.loadNotifications(ctx, query, nodes,
+			func(n *User) { n.Edges.Notifications = []*Notification{} },
+			func(n *User, e *Notification) { n.Edges.Notifications = append(n.Edges.Notifications, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := uq.withSentInvites; query != nil {
 		if err := uq.loadSentInvites(ctx, query, nodes,
 			func(n *User) { n.Edges.SentInvites = []*Invite{} },
@@ -663,6 +793,13 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withTaskWatchers; query != nil {
+		if err := uq.loadTaskWatchers(ctx, query, nodes,
+			func(n *User) { n.Edges.TaskWatchers = []*TaskWatcher{} },
+			func(n *User, e *TaskWatcher) { n.Edges.TaskWatchers = append(n.Edges.TaskWatchers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -788,6 +925,97 @@ func (uq *UserQuery) loadProjects(ctx context.Context, query *ProjectQuery, node
 	}
 	return nil
 }
+func (uq *UserQuery) loadWatchedTasks(ctx context.Context, query *TaskQuery, nodes []*User, init func(*User), assign func(*User, *Task)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*User)
+	nids := make(map[uuid.UUID]map[*User]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(user.WatchedTasksTable)
+		s.Join(joinT).On(s.C(task.FieldID), joinT.C(user.WatchedTasksPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(user.WatchedTasksPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(user.WatchedTasksPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*User]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Task](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "watched_tasks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uq *UserQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*User, init func(*User), assign func(*User, *Notification)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(notification.FieldUserID)
+	}
+	query.Where(predicate.Notification(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.NotificationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (uq *UserQuery) loadSentInvites(ctx context.Context, query *InviteQuery, nodes []*User, init func(*User), assign func(*User, *Invite)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*User)
@@ -942,9 +1170,42 @@ func (uq *UserQuery) loadProjectMemberships(ctx context.Context, query *ProjectM
 	}
 	return nil
 }
+func (uq *UserQuery) loadTaskWatchers(ctx context.Context, query *TaskWatcherQuery, nodes []*User, init func(*User), assign func(*User, *TaskWatcher)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taskwatcher.FieldUserID)
+	}
+	query.Where(predicate.TaskWatcher(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TaskWatchersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	if len(uq.modifiers) > 0 {
+		_spec.Modifiers = uq.modifiers
+	}
 	_spec.Node.Columns = uq.ctx.Fields
 	if len(uq.ctx.Fields) > 0 {
 		_spec.Unique = uq.ctx.Unique != nil && *uq.ctx.Unique
@@ -1013,6 +1274,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.ctx.Unique != nil && *uq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range uq.modifiers {
+		m(selector)
+	}
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -1030,6 +1294,12 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (uq *UserQuery) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	uq.modifiers = append(uq.modifiers, modifiers...)
+	return uq.Select()
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -1119,3 +1389,9 @@ func (us *UserSelect) sqlScan(ctx context.Context, root *UserQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (us *UserSelect) Modify(modifiers ...func(s *sql.Selector)) *UserSelect {
+	us.modifiers = append(us.modifiers, modifiers...)
+	return us
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/apikey"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyKey is the context key for the API key a request was authenticated with
+const APIKeyKey = "api_key"
+
+// API key scopes
+const (
+	// ScopeRead allows GET requests
+	ScopeRead = "read"
+	// ScopeWrite allows every other method
+	ScopeWrite = "write"
+)
+
+// Scopes lists every API key scope
+var Scopes = []string{ScopeRead, ScopeWrite}
+
+const (
+	// apiKeyPrefix starts every key, so leaked keys are easy to recognize
+	apiKeyPrefix = "ttk_"
+	// apiKeyDisplayLen is how much of a key is stored in the clear for display
+	apiKeyDisplayLen = len(apiKeyPrefix) + 6
+	// apiKeyTouchInterval limits how often last_used_at is written for a busy key
+	apiKeyTouchInterval = time.Minute
+)
+
+// APIKeys looks up API keys in the database
+type APIKeys struct {
+	client *ent.Client
+}
+
+// NewAPIKeys creates a database-backed API key store
+func NewAPIKeys(client *ent.Client) *APIKeys {
+	return &APIKeys{client: client}
+}
+
+// GenerateAPIKey creates a new random key. It returns the key to hand to the user once,
+// the prefix to display and the hash to store.
+func GenerateAPIKey() (key, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key = apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLen], HashAPIKey(key), nil
+}
+
+// HashAPIKey hashes a key for storage and lookup. Keys are long and random, so a plain
+// SHA-256 is enough and allows looking them up directly.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticate returns the key, with its organization loaded, or an error if it doesn't exist
+func (k *APIKeys) Authenticate(ctx context.Context, key string) (*ent.APIKey, error) {
+	return k.client.APIKey.Query().
+		Where(apikey.HashedKeyEQ(HashAPIKey(key))).
+		WithOrganization().
+		Only(ctx)
+}
+
+// touch records that a key was used, in the background and at most once per interval
+func (k *APIKeys) touch(key *ent.APIKey) {
+	now := time.Now()
+	if key.LastUsedAt != nil && now.Sub(*key.LastUsedAt) < apiKeyTouchInterval {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := k.client.APIKey.UpdateOneID(key.ID).SetLastUsedAt(now).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			log.Printf("failed to update last use of API key %s: %v", key.ID, err)
+		}
+	}()
+}
+
+// APIKeyMiddleware authenticates requests with an "Authorization: ApiKey <key>" header and
+// passes every other request on to AuthMiddleware. A key acts as the user who created it, but
+// only on routes of its own organization (those with a matching :slug) and only with the
+// methods its scopes allow.
+func APIKeyMiddleware(keys *APIKeys, jwtService *JWTService) echo.MiddlewareFunc {
+	jwtAuth := AuthMiddleware(jwtService)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withJWT := jwtAuth(next)
+		return func(c echo.Context) error {
+			scheme, key, ok := strings.Cut(c.Request().Header.Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, "apikey") {
+				return withJWT(c)
+			}
+
+			apiKey, err := keys.Authenticate(c.Request().Context(), key)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return echo.NewHTTPError(http.StatusUnauthorized, "invalid api key")
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to verify api key")
+			}
+
+			if c.Param("slug") != apiKey.Edges.Organization.Slug {
+				return echo.NewHTTPError(http.StatusForbidden, "api key is not valid for this resource")
+			}
+
+			scope := ScopeWrite
+			if m := c.Request().Method; m == http.MethodGet || m == http.MethodHead {
+				scope = ScopeRead
+			}
+			if !slices.Contains(apiKey.Scopes, scope) {
+				return echo.NewHTTPError(http.StatusForbidden, "api key does not have the "+scope+" scope")
+			}
+
+			keys.touch(apiKey)
+
+			c.Set(UserIDKey, apiKey.CreatedByID)
+			c.Set(APIKeyKey, apiKey)
+
+			return next(c)
+		}
+	}
+}
+
+// GetAPIKey retrieves the API key the request was authenticated with, if any
+func GetAPIKey(c echo.Context) (*ent.APIKey, bool) {
+	key, ok := c.Get(APIKeyKey).(*ent.APIKey)
+	return key, ok
+}
@@ -1,8 +1,12 @@
 package auth
 
 import (
+	"context"
+	"crypto/rsa"
 	"errors"
+	"fmt"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,43 +16,164 @@ import (
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token has expired")
+	ErrRevokedToken = errors.New("token has been revoked")
+	ErrReusedToken  = errors.New("refresh token has already been used")
 )
 
-// Claims represents the JWT claims
+// RevocationStore records revoked refresh tokens by their jti. Revoke reports whether the
+// call revoked the token, as opposed to finding it already revoked, so that two requests
+// racing to rotate the same token can't both succeed.
+type RevocationStore interface {
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+	Revoke(ctx context.Context, claims *RefreshTokenClaims) (bool, error)
+}
+
+// Claims represents the JWT claims.
+// Mutable profile data such as the display name is intentionally not embedded,
+// since it would go stale after a rename until the token is refreshed; handlers
+// that need it read it from the database.
+//
+// Organization is the exception, and only when JWT_ORG_CLAIMS is enabled: it saves the
+// membership lookup on every request to the user's current organization. The price is that
+// a role change or removal from the organization only takes effect once the token is
+// refreshed, up to the access token lifetime later, and that the claim names whichever
+// organization was current when the token was issued.
 type Claims struct {
-	UserID      uuid.UUID `json:"user_id"`
-	Email       string    `json:"email"`
-	DisplayName string    `json:"display_name"`
+	UserID       uuid.UUID          `json:"user_id"`
+	Email        string             `json:"email"`
+	Organization *OrganizationClaim `json:"org,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// OrganizationClaim is the user's current organization and their role in it, as of when the
+// access token was issued
+type OrganizationClaim struct {
+	ID       uuid.UUID `json:"id"`
+	Slug     string    `json:"slug"`
+	Role     string    `json:"role"`
+	ReadOnly bool      `json:"read_only,omitempty"`
+}
+
 // JWTService handles JWT token operations
 type JWTService struct {
 	secretKey     []byte
+	method        jwt.SigningMethod
+	signingKey    interface{}
+	keyID         string
+	publicKeys    map[string]*rsa.PublicKey // RS256 verification keys by kid
 	accessExpiry  time.Duration
 	refreshExpiry time.Duration
+	revocations   RevocationStore
+	orgClaims     bool // embed the current organization in access tokens
 }
 
-// NewJWTService creates a new JWT service
-func NewJWTService() *JWTService {
+// NewJWTService creates a new JWT service that checks refresh tokens against revocations.
+// Tokens are signed with HS256 and JWT_SECRET unless JWT_PRIVATE_KEY holds an RSA private
+// key, in which case they are signed with RS256. JWT_PUBLIC_KEYS may list the public keys of
+// earlier signing keys so that their tokens stay valid while keys are rotated.
+// JWT_ORG_CLAIMS=true embeds the user's current organization in access tokens.
+func NewJWTService(revocations RevocationStore) (*JWTService, error) {
 	secret := os.Getenv("JWT_SECRET")
 	if secret == "" {
 		secret = "your-secret-key-change-in-production" // Default for development
 	}
 
-	return &JWTService{
+	s := &JWTService{
 		secretKey:     []byte(secret),
-		accessExpiry:  15 * time.Minute,      // Access token expires in 15 minutes
-		refreshExpiry: 7 * 24 * time.Hour,    // Refresh token expires in 7 days
+		method:        jwt.SigningMethodHS256,
+		signingKey:    []byte(secret),
+		publicKeys:    make(map[string]*rsa.PublicKey),
+		accessExpiry:  15 * time.Minute,   // Access token expires in 15 minutes
+		refreshExpiry: 7 * 24 * time.Hour, // Refresh token expires in 7 days
+		revocations:   revocations,
+		orgClaims:     os.Getenv("JWT_ORG_CLAIMS") == "true",
+	}
+
+	if raw := os.Getenv("JWT_PUBLIC_KEYS"); raw != "" {
+		keys, err := parseRSAPublicKeys(pemFromEnv(raw))
+		if err != nil {
+			return nil, fmt.Errorf("JWT_PUBLIC_KEYS: %w", err)
+		}
+		for _, key := range keys {
+			kid, err := rsaKeyID(key)
+			if err != nil {
+				return nil, fmt.Errorf("JWT_PUBLIC_KEYS: %w", err)
+			}
+			s.publicKeys[kid] = key
+		}
+	}
+
+	if raw := os.Getenv("JWT_PRIVATE_KEY"); raw != "" {
+		key, err := jwt.ParseRSAPrivateKeyFromPEM(pemFromEnv(raw))
+		if err != nil {
+			return nil, fmt.Errorf("JWT_PRIVATE_KEY: %w", err)
+		}
+		kid, err := rsaKeyID(&key.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_PRIVATE_KEY: %w", err)
+		}
+		s.method = jwt.SigningMethodRS256
+		s.signingKey = key
+		s.keyID = kid
+		s.publicKeys[kid] = &key.PublicKey
 	}
+
+	return s, nil
 }
 
-// GenerateAccessToken creates a new access token
-func (s *JWTService) GenerateAccessToken(userID uuid.UUID, email, displayName string) (string, error) {
+// sign signs claims with the configured method, naming the signing key in the kid header
+func (s *JWTService) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.keyID != "" {
+		token.Header["kid"] = s.keyID
+	}
+	return token.SignedString(s.signingKey)
+}
+
+// verificationKey returns the key for a token. Only the configured signing method is
+// accepted, so "none", an HS256 token forged with a public key as the secret, or a token of
+// the other method all fail; RS256 tokens must also name a known key in their kid header.
+func (s *JWTService) verificationKey(token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() != s.method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	if s.method == jwt.SigningMethodHS256 {
+		return s.secretKey, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.publicKeys[kid]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	return key, nil
+}
+
+// parse verifies a token's signature and standard claims into claims
+func (s *JWTService) parse(tokenString string, claims jwt.Claims, opts ...jwt.ParserOption) (*jwt.Token, error) {
+	opts = append(opts, jwt.WithValidMethods([]string{s.method.Alg()}))
+	return jwt.ParseWithClaims(tokenString, claims, s.verificationKey, opts...)
+}
+
+// OrganizationClaims reports whether access tokens embed the user's current organization
+func (s *JWTService) OrganizationClaims() bool {
+	return s.orgClaims
+}
+
+// AccessTokenExpiry is how long access tokens are valid for
+func (s *JWTService) AccessTokenExpiry() time.Duration {
+	return s.accessExpiry
+}
+
+// GenerateAccessToken creates a new access token. org is embedded when organization claims
+// are enabled and may be nil.
+func (s *JWTService) GenerateAccessToken(userID uuid.UUID, email string, org *OrganizationClaim) (string, error) {
+	if !s.orgClaims {
+		org = nil
+	}
 	claims := &Claims{
-		UserID:      userID,
-		Email:       email,
-		DisplayName: displayName,
+		UserID:       userID,
+		Email:        email,
+		Organization: org,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.accessExpiry)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -58,32 +183,46 @@ func (s *JWTService) GenerateAccessToken(userID uuid.UUID, email, displayName st
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	return s.sign(claims)
+}
+
+// refreshAudience marks refresh tokens. They are signed with the same key as access tokens,
+// and the audience is what keeps them from being accepted as one.
+const refreshAudience = "refresh"
+
+// refreshClaims are the claims of a refresh token. Family is shared by every token rotated
+// from the same login, so that the whole chain can be revoked at once.
+type refreshClaims struct {
+	Family string `json:"fam,omitempty"`
+	jwt.RegisteredClaims
 }
 
-// GenerateRefreshToken creates a new refresh token
+// GenerateRefreshToken creates a new refresh token with a unique ID so that it can be revoked.
+// The token starts a new family.
 func (s *JWTService) GenerateRefreshToken(userID uuid.UUID) (string, error) {
-	claims := &jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.refreshExpiry)),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		NotBefore: jwt.NewNumericDate(time.Now()),
-		Issuer:    "team-todo",
-		Subject:   userID.String(),
+	return s.generateRefreshToken(userID, uuid.NewString())
+}
+
+func (s *JWTService) generateRefreshToken(userID uuid.UUID, family string) (string, error) {
+	claims := &refreshClaims{
+		Family: family,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{refreshAudience},
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(s.refreshExpiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Issuer:    "team-todo",
+			Subject:   userID.String(),
+		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secretKey)
+	return s.sign(claims)
 }
 
 // ValidateAccessToken validates and parses an access token
 func (s *JWTService) ValidateAccessToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return s.secretKey, nil
-	})
+	token, err := s.parse(tokenString, &Claims{})
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -92,41 +231,216 @@ func (s *JWTService) ValidateAccessToken(tokenString string) (*Claims, error) {
 		return nil, ErrInvalidToken
 	}
 
+	// Tokens issued for another audience, such as refresh tokens and calendar feeds, are not
+	// access tokens. Refresh tokens issued before they had an audience carry no user_id.
 	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
+	if !ok || !token.Valid || len(claims.Audience) > 0 || claims.UserID == uuid.Nil {
 		return nil, ErrInvalidToken
 	}
 
 	return claims, nil
 }
 
-// ValidateRefreshToken validates a refresh token and returns the user ID
-func (s *JWTService) ValidateRefreshToken(tokenString string) (uuid.UUID, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
+// calendarAudience marks calendar feed tokens, which grant read access to one user's
+// calendar feed for one organization and nothing else
+const calendarAudience = "calendar"
+
+// calendarTokenExpiry is long because calendar apps are set up once and then poll the feed
+const calendarTokenExpiry = 365 * 24 * time.Hour
+
+// calendarClaims are the claims of a calendar feed token
+type calendarClaims struct {
+	OrganizationID uuid.UUID `json:"org_id"`
+	jwt.RegisteredClaims
+}
+
+// CalendarTokenClaims are the parsed contents of a calendar feed token
+type CalendarTokenClaims struct {
+	UserID         uuid.UUID
+	OrganizationID uuid.UUID
+	IssuedAt       time.Time
+}
+
+// GenerateCalendarToken creates a token for polling a user's calendar feed of an organization.
+// Calendar apps can't send an Authorization header, so the token goes in the feed URL.
+func (s *JWTService) GenerateCalendarToken(userID, orgID uuid.UUID) (string, time.Time, error) {
+	expiresAt := time.Now().Add(calendarTokenExpiry)
+	claims := &calendarClaims{
+		OrganizationID: orgID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+			Issuer:    "team-todo",
+			Subject:   userID.String(),
+			Audience:  jwt.ClaimStrings{calendarAudience},
+		},
+	}
+
+	token, err := s.sign(claims)
+	return token, expiresAt, err
+}
+
+// ValidateCalendarToken validates and parses a calendar feed token
+func (s *JWTService) ValidateCalendarToken(tokenString string) (*CalendarTokenClaims, error) {
+	token, err := s.parse(tokenString, &calendarClaims{}, jwt.WithAudience(calendarAudience))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
 		}
-		return s.secretKey, nil
-	})
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*calendarClaims)
+	if !ok || !token.Valid || claims.IssuedAt == nil {
+		return nil, ErrInvalidToken
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return &CalendarTokenClaims{
+		UserID:         userID,
+		OrganizationID: claims.OrganizationID,
+		IssuedAt:       claims.IssuedAt.Time,
+	}, nil
+}
+
+// RefreshTokenClaims are the parsed contents of a refresh token
+type RefreshTokenClaims struct {
+	UserID    uuid.UUID
+	TokenID   string
+	Family    string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// ParseRefreshToken verifies a refresh token's signature and expiry without checking revocation
+func (s *JWTService) ParseRefreshToken(tokenString string) (*RefreshTokenClaims, error) {
+	token, err := s.parse(tokenString, &refreshClaims{})
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return uuid.Nil, ErrExpiredToken
+			return nil, ErrExpiredToken
 		}
-		return uuid.Nil, ErrInvalidToken
+		return nil, ErrInvalidToken
 	}
 
-	claims, ok := token.Claims.(*jwt.RegisteredClaims)
-	if !ok || !token.Valid {
-		return uuid.Nil, ErrInvalidToken
+	// Refresh tokens issued before they had an audience are still accepted
+	claims, ok := token.Claims.(*refreshClaims)
+	if !ok || !token.Valid || claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return nil, ErrInvalidToken
+	}
+	if len(claims.Audience) > 0 && !slices.Equal(claims.Audience, jwt.ClaimStrings{refreshAudience}) {
+		return nil, ErrInvalidToken
 	}
 
 	userID, err := uuid.Parse(claims.Subject)
 	if err != nil {
-		return uuid.Nil, ErrInvalidToken
+		return nil, ErrInvalidToken
+	}
+
+	// Tokens without an ID predate revocation support and could never be revoked
+	if claims.ID == "" {
+		return nil, ErrInvalidToken
+	}
+
+	// Tokens issued before rotation form a family of their own
+	family := claims.Family
+	if family == "" {
+		family = claims.ID
+	}
+
+	return &RefreshTokenClaims{
+		UserID:    userID,
+		TokenID:   claims.ID,
+		Family:    family,
+		IssuedAt:  claims.IssuedAt.Time,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
+
+// ValidateRefreshToken validates a refresh token, rejecting revoked ones and those of a
+// revoked family, and returns its claims
+func (s *JWTService) ValidateRefreshToken(ctx context.Context, tokenString string) (*RefreshTokenClaims, error) {
+	claims, err := s.ParseRefreshToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range []string{claims.TokenID, claims.Family} {
+		revoked, err := s.revocations.IsRevoked(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, ErrRevokedToken
+		}
+	}
+
+	return claims, nil
+}
+
+// RotateRefreshToken consumes a refresh token so that it can be exchanged for a new pair
+// only once. A token presented again after it was rotated or revoked has probably been
+// copied, and there's no telling whether the attacker or the user holds the newest token of
+// its family, so the whole family is revoked and ErrReusedToken returned.
+func (s *JWTService) RotateRefreshToken(ctx context.Context, tokenString string) (*RefreshTokenClaims, error) {
+	claims, err := s.ParseRefreshToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	familyRevoked, err := s.revocations.IsRevoked(ctx, claims.Family)
+	if err != nil {
+		return nil, err
+	}
+	if familyRevoked {
+		return nil, ErrRevokedToken
+	}
+
+	revoked, err := s.revocations.Revoke(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		if err := s.revokeFamily(ctx, claims); err != nil {
+			return nil, err
+		}
+		return nil, ErrReusedToken
 	}
 
-	return userID, nil
+	return claims, nil
+}
+
+// revokeFamily revokes every token of a refresh token's family. The family is recorded like
+// a revoked token under its id, until every token it could have issued so far has expired.
+func (s *JWTService) revokeFamily(ctx context.Context, claims *RefreshTokenClaims) error {
+	now := time.Now()
+	_, err := s.revocations.Revoke(ctx, &RefreshTokenClaims{
+		UserID:    claims.UserID,
+		TokenID:   claims.Family,
+		Family:    claims.Family,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(s.refreshExpiry),
+	})
+	return err
+}
+
+// RevokeRefreshToken invalidates a refresh token along with the rest of its family, ending
+// the session it belongs to. Tokens that are already invalid or expired cannot be used
+// anyway, so they are ignored rather than reported as errors.
+func (s *JWTService) RevokeRefreshToken(ctx context.Context, tokenString string) error {
+	claims, err := s.ParseRefreshToken(tokenString)
+	if err != nil {
+		return nil
+	}
+	if _, err := s.revocations.Revoke(ctx, claims); err != nil {
+		return err
+	}
+	return s.revokeFamily(ctx, claims)
 }
 
 // TokenPair represents an access and refresh token pair
@@ -136,14 +450,24 @@ type TokenPair struct {
 	ExpiresIn    int64  `json:"expires_in"` // Access token expiry in seconds
 }
 
-// GenerateTokenPair creates both access and refresh tokens
-func (s *JWTService) GenerateTokenPair(userID uuid.UUID, email, displayName string) (*TokenPair, error) {
-	accessToken, err := s.GenerateAccessToken(userID, email, displayName)
+// GenerateTokenPair creates both access and refresh tokens, starting a new refresh token family
+func (s *JWTService) GenerateTokenPair(userID uuid.UUID, email string, org *OrganizationClaim) (*TokenPair, error) {
+	return s.generateTokenPair(userID, email, org, uuid.NewString())
+}
+
+// GenerateRotatedTokenPair creates the pair that replaces a refresh token consumed by
+// RotateRefreshToken, continuing its family
+func (s *JWTService) GenerateRotatedTokenPair(rotated *RefreshTokenClaims, email string, org *OrganizationClaim) (*TokenPair, error) {
+	return s.generateTokenPair(rotated.UserID, email, org, rotated.Family)
+}
+
+func (s *JWTService) generateTokenPair(userID uuid.UUID, email string, org *OrganizationClaim, family string) (*TokenPair, error) {
+	accessToken, err := s.GenerateAccessToken(userID, email, org)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.GenerateRefreshToken(userID)
+	refreshToken, err := s.generateRefreshToken(userID, family)
 	if err != nil {
 		return nil, err
 	}
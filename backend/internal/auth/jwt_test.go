@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// newTestRSAKey generates an RSA key and returns it with its private key PEM
+func newTestRSAKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	return key, string(pem.EncodeToMemory(block))
+}
+
+// newTestJWTService creates a service from the JWT_* variables set by the test
+func newTestJWTService(t *testing.T) *JWTService {
+	t.Helper()
+	s, err := NewJWTService(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// testClaims returns valid access token claims for a new user
+func testClaims() *Claims {
+	userID := uuid.New()
+	return &Claims{
+		UserID: userID,
+		Email:  "user@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Subject:   userID.String(),
+		},
+	}
+}
+
+// signTestToken signs claims with method and key, setting kid when not empty
+func signTestToken(t *testing.T, claims jwt.Claims, method jwt.SigningMethod, key interface{}, kid string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestValidateAccessTokenHS256(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	s := newTestJWTService(t)
+
+	token, err := s.GenerateAccessToken(uuid.New(), "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ValidateAccessToken(token); err != nil {
+		t.Fatalf("own token rejected: %v", err)
+	}
+
+	rsaKey, _ := newTestRSAKey(t)
+	expired := testClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	calendar, _, err := s.GenerateCalendarToken(uuid.New(), uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	refresh, err := s.GenerateRefreshToken(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyRefresh := signTestToken(t, &refreshClaims{RegisteredClaims: jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Subject:   uuid.NewString(),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}}, jwt.SigningMethodHS256, []byte("test-secret"), "")
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"alg none", signTestToken(t, testClaims(), jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, ""), ErrInvalidToken},
+		{"RS256", signTestToken(t, testClaims(), jwt.SigningMethodRS256, rsaKey, ""), ErrInvalidToken},
+		{"HS512 with the secret", signTestToken(t, testClaims(), jwt.SigningMethodHS512, []byte("test-secret"), ""), ErrInvalidToken},
+		{"other secret", signTestToken(t, testClaims(), jwt.SigningMethodHS256, []byte("other-secret"), ""), ErrInvalidToken},
+		{"expired", signTestToken(t, expired, jwt.SigningMethodHS256, []byte("test-secret"), ""), ErrExpiredToken},
+		{"calendar token", calendar, ErrInvalidToken},
+		{"refresh token", refresh, ErrInvalidToken},
+		{"refresh token without an audience", legacyRefresh, ErrInvalidToken},
+		{"malformed", "not.a.token", ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		if _, err := s.ValidateAccessToken(tt.token); !errors.Is(err, tt.want) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestValidateAccessTokenRS256(t *testing.T) {
+	key, keyPEM := newTestRSAKey(t)
+	t.Setenv("JWT_SECRET", "test-secret")
+	t.Setenv("JWT_PRIVATE_KEY", keyPEM)
+	s := newTestJWTService(t)
+
+	token, err := s.GenerateAccessToken(uuid.New(), "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ValidateAccessToken(token); err != nil {
+		t.Fatalf("own token rejected: %v", err)
+	}
+
+	kid, err := rsaKeyID(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicPEM})
+	otherKey, _ := newTestRSAKey(t)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"alg none", signTestToken(t, testClaims(), jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, kid)},
+		{"HS256 with the public key as secret", signTestToken(t, testClaims(), jwt.SigningMethodHS256, publicKey, kid)},
+		{"HS256 with the old secret", signTestToken(t, testClaims(), jwt.SigningMethodHS256, []byte("test-secret"), "")},
+		{"other key under a known kid", signTestToken(t, testClaims(), jwt.SigningMethodRS256, otherKey, kid)},
+		{"no kid", signTestToken(t, testClaims(), jwt.SigningMethodRS256, key, "")},
+	}
+	for _, tt := range tests {
+		if _, err := s.ValidateAccessToken(tt.token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: error %v, want %v", tt.name, err, ErrInvalidToken)
+		}
+	}
+}
+
+func TestParseRefreshTokenRejectsOtherTokens(t *testing.T) {
+	t.Setenv("JWT_SECRET", "test-secret")
+	s := newTestJWTService(t)
+	userID := uuid.New()
+
+	refresh, err := s.GenerateRefreshToken(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims, err := s.ParseRefreshToken(refresh); err != nil || claims.UserID != userID {
+		t.Fatalf("own refresh token: claims %+v, error %v", claims, err)
+	}
+	legacy := signTestToken(t, &refreshClaims{RegisteredClaims: jwt.RegisteredClaims{
+		ID:        uuid.NewString(),
+		Subject:   userID.String(),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}}, jwt.SigningMethodHS256, []byte("test-secret"), "")
+	if _, err := s.ParseRefreshToken(legacy); err != nil {
+		t.Errorf("refresh token issued before the audience: %v", err)
+	}
+
+	access, err := s.GenerateAccessToken(userID, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	calendar, _, err := s.GenerateCalendarToken(userID, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, token := range map[string]string{"access token": access, "calendar token": calendar} {
+		if _, err := s.ParseRefreshToken(token); !errors.Is(err, ErrInvalidToken) {
+			t.Errorf("%s: error %v, want %v", name, err, ErrInvalidToken)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// pemFromEnv restores newlines in PEM data that was written to a single-line env var as "\n"
+func pemFromEnv(value string) []byte {
+	return []byte(strings.ReplaceAll(value, `\n`, "\n"))
+}
+
+// parseRSAPublicKeys parses every PEM block in data as an RSA public key
+func parseRSAPublicKeys(data []byte) ([]*rsa.PublicKey, error) {
+	var keys []*rsa.PublicKey
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, fmt.Errorf("public key %d: %w", len(keys)+1, err)
+		}
+		keys = append(keys, key)
+		data = rest
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM encoded public keys found")
+	}
+	return keys, nil
+}
+
+// rsaKeyID derives a stable key id from the public key, so a kid never has to be configured
+// by hand and a token always names the exact key that verifies it
+func rsaKeyID(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:])[:16], nil
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -12,7 +13,6 @@ import (
 const (
 	UserIDKey      = "user_id"
 	UserEmailKey   = "user_email"
-	UserNameKey    = "user_name"
 	UserClaimsKey  = "user_claims"
 )
 
@@ -46,8 +46,10 @@ func AuthMiddleware(jwtService *JWTService) echo.MiddlewareFunc {
 			// Store user info in context
 			c.Set(UserIDKey, claims.UserID)
 			c.Set(UserEmailKey, claims.Email)
-			c.Set(UserNameKey, claims.DisplayName)
 			c.Set(UserClaimsKey, claims)
+			if isReadRequest(c.Request()) {
+				c.SetRequest(c.Request().WithContext(WithClaims(c.Request().Context(), claims)))
+			}
 
 			return next(c)
 		}
@@ -73,8 +75,10 @@ func OptionalAuthMiddleware(jwtService *JWTService) echo.MiddlewareFunc {
 			if err == nil {
 				c.Set(UserIDKey, claims.UserID)
 				c.Set(UserEmailKey, claims.Email)
-				c.Set(UserNameKey, claims.DisplayName)
 				c.Set(UserClaimsKey, claims)
+				if isReadRequest(c.Request()) {
+					c.SetRequest(c.Request().WithContext(WithClaims(c.Request().Context(), claims)))
+				}
 			}
 
 			return next(c)
@@ -100,3 +104,24 @@ func GetClaims(c echo.Context) (*Claims, bool) {
 	return claims, ok
 }
 
+type claimsKey struct{}
+
+// isReadRequest reports whether r only reads data. The middlewares only put the claims into
+// the context of such requests: code that has nothing but the request context uses them to
+// skip the membership lookup (see Claims), and a role that may be as old as the token is good
+// enough to read with, but changes are authorized against the current membership.
+func isReadRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet || r.Method == http.MethodHead
+}
+
+// WithClaims returns a copy of ctx carrying access token claims, for code that only has the
+// request context
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the access token claims ctx carries, if any
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
@@ -1,17 +1,40 @@
 package auth
 
 import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
 const (
-	// DefaultCost is the bcrypt cost for password hashing
+	// DefaultCost is the bcrypt cost for password hashing unless BCRYPT_COST says otherwise
 	DefaultCost = 12
+	// MinCost and MaxCost bound BCRYPT_COST: below 10 hashes are too cheap to brute-force,
+	// above 15 a login takes seconds
+	MinCost = 10
+	MaxCost = 15
 )
 
+// PasswordCost is the bcrypt cost new password hashes use: BCRYPT_COST clamped to
+// [MinCost, MaxCost], or DefaultCost when it is unset or not a number
+var PasswordCost = sync.OnceValue(func() int {
+	cost, err := strconv.Atoi(os.Getenv("BCRYPT_COST"))
+	if err != nil {
+		return DefaultCost
+	}
+	return min(max(cost, MinCost), MaxCost)
+})
+
 // HashPassword hashes a plain text password using bcrypt
 func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), DefaultCost)
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), PasswordCost())
 	if err != nil {
 		return "", err
 	}
@@ -24,3 +47,83 @@ func CheckPassword(password, hash string) bool {
 	return err == nil
 }
 
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords is the embedded list as a set of lowercased passwords
+var commonPasswords = func() map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			set[strings.ToLower(line)] = true
+		}
+	}
+	return set
+}()
+
+// DefaultPasswordMinLength is the shortest password accepted unless the policy raises it
+const DefaultPasswordMinLength = 8
+
+// MaxPasswordBytes is the longest password bcrypt can hash, in bytes
+const MaxPasswordBytes = 72
+
+// PasswordPolicy lists the rules new passwords must satisfy
+type PasswordPolicy struct {
+	MinLength        int
+	RequireDigit     bool
+	RequireMixedCase bool
+	RejectCommon     bool
+}
+
+// PasswordPolicyFromEnv reads the policy from the environment. Only the minimum length applies
+// by default; PASSWORD_MIN_LENGTH can raise it up to MaxPasswordBytes but not lower it below
+// the default, and the other rules are enabled with PASSWORD_REQUIRE_DIGIT,
+// PASSWORD_REQUIRE_MIXED_CASE and PASSWORD_REJECT_COMMON set to "true".
+func PasswordPolicyFromEnv() PasswordPolicy {
+	minLength := DefaultPasswordMinLength
+	if v, err := strconv.Atoi(os.Getenv("PASSWORD_MIN_LENGTH")); err == nil && v > minLength {
+		minLength = min(v, MaxPasswordBytes)
+	}
+
+	return PasswordPolicy{
+		MinLength:        minLength,
+		RequireDigit:     os.Getenv("PASSWORD_REQUIRE_DIGIT") == "true",
+		RequireMixedCase: os.Getenv("PASSWORD_REQUIRE_MIXED_CASE") == "true",
+		RejectCommon:     os.Getenv("PASSWORD_REJECT_COMMON") == "true",
+	}
+}
+
+// Violations describes each rule the password breaks, e.g. "must contain a digit", or returns
+// nil if it satisfies the policy. Passwords longer than MaxPasswordBytes always break it.
+func (p PasswordPolicy) Violations(password string) []string {
+	var violations []string
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters", p.MinLength))
+	}
+	if len(password) > MaxPasswordBytes {
+		violations = append(violations, fmt.Sprintf("must be at most %d bytes (fewer characters if it contains non-ASCII letters)", MaxPasswordBytes))
+	}
+
+	var hasDigit, hasUpper, hasLower bool
+	for _, r := range password {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		}
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, "must contain a digit")
+	}
+	if p.RequireMixedCase && !(hasUpper && hasLower) {
+		violations = append(violations, "must contain both upper and lower case letters")
+	}
+	if p.RejectCommon && commonPasswords[strings.ToLower(password)] {
+		violations = append(violations, "is too common")
+	}
+	return violations
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicyViolations(t *testing.T) {
+	strict := PasswordPolicy{MinLength: 10, RequireDigit: true, RequireMixedCase: true, RejectCommon: true}
+	tooLong := "must be at most 72 bytes (fewer characters if it contains non-ASCII letters)"
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		want     []string
+	}{
+		{"default policy accepts", PasswordPolicy{MinLength: DefaultPasswordMinLength}, "password", nil},
+		{"too short", PasswordPolicy{MinLength: DefaultPasswordMinLength}, "passwor", []string{"must be at least 8 characters"}},
+		{"length counts characters", PasswordPolicy{MinLength: DefaultPasswordMinLength}, "пароль", []string{"must be at least 8 characters"}},
+		{"72 bytes", PasswordPolicy{MinLength: DefaultPasswordMinLength}, strings.Repeat("a", 72), nil},
+		{"73 bytes", PasswordPolicy{MinLength: DefaultPasswordMinLength}, strings.Repeat("a", 73), []string{tooLong}},
+		{"multi-byte characters over 72 bytes", PasswordPolicy{MinLength: DefaultPasswordMinLength}, strings.Repeat("é", 37), []string{tooLong}},
+		{"strict policy accepts", strict, "Correct4Horse", nil},
+		{"every rule broken", strict, "password", []string{
+			"must be at least 10 characters",
+			"must contain a digit",
+			"must contain both upper and lower case letters",
+			"is too common",
+		}},
+		{"common passwords ignore case", PasswordPolicy{RejectCommon: true}, "PassWord", []string{"is too common"}},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.Violations(tt.password); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Violations(%q) = %q, want %q", tt.name, tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestPasswordPolicyFromEnv(t *testing.T) {
+	tests := []struct {
+		minLength string
+		want      int
+	}{
+		{"", DefaultPasswordMinLength},
+		{"4", DefaultPasswordMinLength},
+		{"12", 12},
+		{"100", MaxPasswordBytes},
+	}
+	for _, tt := range tests {
+		t.Setenv("PASSWORD_MIN_LENGTH", tt.minLength)
+		if got := PasswordPolicyFromEnv().MinLength; got != tt.want {
+			t.Errorf("PASSWORD_MIN_LENGTH=%q: MinLength %d, want %d", tt.minLength, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"backend/ent"
+	"backend/ent/revokedtoken"
+)
+
+// TokenRevocations stores revoked refresh tokens in the database
+type TokenRevocations struct {
+	client *ent.Client
+}
+
+// NewTokenRevocations creates a database-backed revocation store
+func NewTokenRevocations(client *ent.Client) *TokenRevocations {
+	return &TokenRevocations{client: client}
+}
+
+// IsRevoked implements RevocationStore
+func (r *TokenRevocations) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return r.client.RevokedToken.Query().
+		Where(revokedtoken.TokenIDEQ(tokenID)).
+		Exist(ctx)
+}
+
+// Revoke implements RevocationStore. Revoking an already revoked token changes nothing and
+// reports false.
+func (r *TokenRevocations) Revoke(ctx context.Context, claims *RefreshTokenClaims) (bool, error) {
+	err := r.client.RevokedToken.Create().
+		SetTokenID(claims.TokenID).
+		SetUserID(claims.UserID).
+		SetExpiresAt(claims.ExpiresAt).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return false, err
+	}
+
+	// Rows for tokens that have expired anyway can go
+	_, _ = r.client.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	return err == nil, nil
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"backend/ent/enttest"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestRevokingJWTService creates a service that stores revocations in a fresh SQLite
+// database, and returns it with the id of a user to issue tokens for
+func newTestRevokingJWTService(t *testing.T) (*JWTService, uuid.UUID) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	s, err := NewJWTService(NewTokenRevocations(client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := client.User.Create().
+		SetEmail("user@example.com").
+		SetPasswordHash("unused").
+		SetDisplayName("User").
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, u.ID
+}
+
+func TestRotateRefreshTokenDetectsReuse(t *testing.T) {
+	ctx := context.Background()
+	s, userID := newTestRevokingJWTService(t)
+
+	login, err := s.GenerateTokenPair(userID, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSession, err := s.GenerateTokenPair(userID, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := s.RotateRefreshToken(ctx, login.RefreshToken)
+	if err != nil {
+		t.Fatalf("first rotation: %v", err)
+	}
+	refreshed, err := s.GenerateRotatedTokenPair(rotated, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ValidateRefreshToken(ctx, refreshed.RefreshToken); err != nil {
+		t.Fatalf("rotated token: %v", err)
+	}
+
+	// Presenting the consumed token again revokes the family, including the token it was
+	// exchanged for
+	if _, err := s.RotateRefreshToken(ctx, login.RefreshToken); !errors.Is(err, ErrReusedToken) {
+		t.Fatalf("reused token: got %v, want %v", err, ErrReusedToken)
+	}
+	if _, err := s.ValidateRefreshToken(ctx, refreshed.RefreshToken); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("validating the newest token of the family: got %v, want %v", err, ErrRevokedToken)
+	}
+	if _, err := s.RotateRefreshToken(ctx, refreshed.RefreshToken); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("rotating the newest token of the family: got %v, want %v", err, ErrRevokedToken)
+	}
+
+	// Other sessions of the user are unaffected
+	if _, err := s.RotateRefreshToken(ctx, otherSession.RefreshToken); err != nil {
+		t.Errorf("rotating another session's token: %v", err)
+	}
+}
+
+func TestRevokeRefreshTokenEndsTheSession(t *testing.T) {
+	ctx := context.Background()
+	s, userID := newTestRevokingJWTService(t)
+
+	login, err := s.GenerateTokenPair(userID, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := s.RotateRefreshToken(ctx, login.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshed, err := s.GenerateRotatedTokenPair(rotated, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Logging out with the consumed token still ends the session
+	if err := s.RevokeRefreshToken(ctx, login.RefreshToken); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RotateRefreshToken(ctx, refreshed.RefreshToken); !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("rotating after logout: got %v, want %v", err, ErrRevokedToken)
+	}
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"backend/ent"
+	"backend/ent/organization"
+	"backend/ent/organizationmember"
+	"backend/ent/predicate"
+	"backend/ent/project"
+	"backend/ent/projectmember"
+	"backend/ent/schema"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// loadOrgMembership resolves the organization for a /organizations/:slug route and the caller's membership in it
+func loadOrgMembership(ctx context.Context, client *ent.Client, userID uuid.UUID, slug string) (*ent.Organization, *ent.OrganizationMember, error) {
+	if slug == "" {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "organization slug is required")
+	}
+
+	if org, membership := claimedOrgMembership(ctx, client, userID, slug); membership != nil {
+		return org, membership, nil
+	}
+
+	// Get organization
+	org, err := client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, echo.NewHTTPError(http.StatusNotFound, "organization not found")
+		}
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+	}
+
+	// Check membership
+	membership, err := client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
+		}
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	}
+
+	return org, membership, nil
+}
+
+// claimedOrgMembership is the fast path of loadOrgMembership, taking the membership from the
+// access token's organization claim instead of the database. The role it returns may be as
+// old as the token (see auth.Claims), so only GET and HEAD requests carry the claims in their
+// context. It returns a nil membership whenever the claim doesn't cover the request,
+// including when the organization's slug has changed since, and the caller then falls back
+// to the database.
+func claimedOrgMembership(ctx context.Context, client *ent.Client, userID uuid.UUID, slug string) (*ent.Organization, *ent.OrganizationMember) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.UserID != userID || claims.Organization == nil || claims.Organization.Slug != slug {
+		return nil, nil
+	}
+
+	org, err := client.Organization.Get(ctx, claims.Organization.ID)
+	if err != nil || org.Slug != slug {
+		return nil, nil
+	}
+
+	return org, &ent.OrganizationMember{
+		UserID:         userID,
+		OrganizationID: org.ID,
+		Role:           organizationmember.Role(claims.Organization.Role),
+		ReadOnly:       claims.Organization.ReadOnly,
+	}
+}
+
+// hidePrivateProjects makes project routes answer 404 instead of 403 for private projects the
+// caller can't access, so that their existence isn't disclosed (HIDE_PRIVATE_PROJECTS=true)
+var hidePrivateProjects = os.Getenv("HIDE_PRIVATE_PROJECTS") == "true"
+
+// errNoProjectAccess returns the error for a project of the caller's organization that
+// EffectiveProjectPermission denies them access to. Every project route answers with it, so
+// that none of them tells a hidden private project apart from a missing one.
+func errNoProjectAccess() error {
+	if hidePrivateProjects {
+		return echo.NewHTTPError(http.StatusNotFound, "project not found")
+	}
+	return echo.NewHTTPError(http.StatusForbidden, "you do not have access to this project")
+}
+
+// projectAccess is the caller's resolved access to a project within an organization
+type projectAccess struct {
+	Org        *ent.Organization
+	Membership *ent.OrganizationMember
+	Project    *ent.Project
+	Permission projectmember.Permission
+}
+
+// loadProjectAccess resolves the organization, membership, project and the caller's permission
+// for a /organizations/:slug/projects/:project_id route, as EffectiveProjectPermission does.
+func loadProjectAccess(ctx context.Context, client *ent.Client, userID uuid.UUID, slug, projectIDStr string) (*projectAccess, error) {
+	if slug == "" || projectIDStr == "" {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "organization slug and project_id are required")
+	}
+
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid project_id format")
+	}
+
+	org, membership, err := loadOrgMembership(ctx, client, userID, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get project
+	proj, err := client.Project.Query().
+		Where(
+			project.IDEQ(projectID),
+			project.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get project")
+	}
+
+	permission, hasAccess, err := EffectiveProjectPermission(ctx, client, org, membership, proj)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to check project membership")
+	}
+	if !hasAccess {
+		return nil, errNoProjectAccess()
+	}
+	return &projectAccess{
+		Org:        org,
+		Membership: membership,
+		Project:    proj,
+		Permission: projectmember.Permission(permission),
+	}, nil
+}
+
+// requireEdit rejects callers without edit permission on the project, including read-only members
+func (a *projectAccess) requireEdit() error {
+	if err := requireWriteAccess(a.Membership); err != nil {
+		return err
+	}
+	if a.Permission != projectmember.PermissionEdit {
+		return echo.NewHTTPError(http.StatusForbidden, "you do not have edit permission for this project")
+	}
+	return nil
+}
+
+// projectPermission resolves a member's permission on a project of their organization from
+// their explicit project permission, nil when they aren't a project member. Owners and admins
+// can edit every project, private ones included; project members get their stored permission;
+// everyone else gets the organization's default project permission on public projects and no
+// access to private ones. It reports whether the member can access the project at all.
+func projectPermission(org *ent.Organization, membership *ent.OrganizationMember, proj *ent.Project, explicit *projectmember.Permission) (projectmember.Permission, bool) {
+	if HasAdminPermission(membership.Role) {
+		return projectmember.PermissionEdit, true
+	}
+	if explicit != nil {
+		return *explicit, true
+	}
+	if proj.IsPrivate {
+		return "", false
+	}
+	return projectmember.Permission(org.DefaultProjectPermission), true
+}
+
+// EffectiveProjectPermission resolves a member's permission on a project of their organization,
+// reporting whether they can access it at all. Listings that resolve many projects at once
+// load the explicit permissions in one query and call projectPermission instead.
+func EffectiveProjectPermission(ctx context.Context, client *ent.Client, org *ent.Organization, membership *ent.OrganizationMember, proj *ent.Project) (string, bool, error) {
+	var explicit *projectmember.Permission
+	pm, err := client.ProjectMember.Query().
+		Where(
+			projectmember.UserIDEQ(membership.UserID),
+			projectmember.ProjectIDEQ(proj.ID),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		explicit = &pm.Permission
+	case !ent.IsNotFound(err):
+		return "", false, err
+	}
+
+	permission, ok := projectPermission(org, membership, proj, explicit)
+	return string(permission), ok, nil
+}
+
+// explicitProjectPermissions loads a user's explicit permissions on the given projects
+func explicitProjectPermissions(ctx context.Context, client *ent.Client, userID uuid.UUID, projectIDs []uuid.UUID) (map[uuid.UUID]*projectmember.Permission, error) {
+	memberships, err := client.ProjectMember.Query().
+		Where(
+			projectmember.UserIDEQ(userID),
+			projectmember.ProjectIDIn(projectIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	permissions := make(map[uuid.UUID]*projectmember.Permission, len(memberships))
+	for _, pm := range memberships {
+		permissions[pm.ProjectID] = &pm.Permission
+	}
+	return permissions, nil
+}
+
+// visibleProject matches the projects a user sees in listings: every public project, plus the
+// private projects they are a member of, plus every project of the organizations they own or
+// administer
+func visibleProject(userID uuid.UUID) predicate.Project {
+	return project.Or(
+		project.IsPrivateEQ(false),
+		project.HasProjectMembershipsWith(projectmember.UserIDEQ(userID)),
+		project.HasOrganizationWith(organization.HasOrganizationMembershipsWith(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.RoleIn(organizationmember.RoleOwner, organizationmember.RoleAdmin),
+		)),
+	)
+}
+
+// includeDeletedContext handles ?include_deleted=true, which lets owners and admins see
+// soft-deleted rows in a listing. It returns the context to run the listing query with.
+func includeDeletedContext(c echo.Context, ctx context.Context, membership *ent.OrganizationMember) (context.Context, error) {
+	if c.QueryParam("include_deleted") != "true" {
+		return ctx, nil
+	}
+	if err := refuseAPIKey(c); err != nil {
+		return nil, err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "only owners and admins can view deleted items")
+	}
+	return schema.SkipSoftDelete(ctx), nil
+}
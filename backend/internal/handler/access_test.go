@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/ent"
+	"backend/ent/organization"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/internal/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestProjectPermission(t *testing.T) {
+	view := projectmember.PermissionView
+	edit := projectmember.PermissionEdit
+
+	tests := []struct {
+		name       string
+		role       organizationmember.Role
+		private    bool
+		explicit   *projectmember.Permission
+		orgDefault organization.DefaultProjectPermission
+		want       projectmember.Permission
+		wantAccess bool
+	}{
+		{"owner on a private project", organizationmember.RoleOwner, true, nil, organization.DefaultProjectPermissionView, edit, true},
+		{"admin on a private project", organizationmember.RoleAdmin, true, nil, organization.DefaultProjectPermissionView, edit, true},
+		{"admin with an explicit view permission", organizationmember.RoleAdmin, false, &view, organization.DefaultProjectPermissionView, edit, true},
+		{"member on a public project", organizationmember.RoleMember, false, nil, organization.DefaultProjectPermissionView, view, true},
+		{"member on a public project of an edit-by-default organization", organizationmember.RoleMember, false, nil, organization.DefaultProjectPermissionEdit, edit, true},
+		{"member with view overriding an edit default", organizationmember.RoleMember, false, &view, organization.DefaultProjectPermissionEdit, view, true},
+		{"member with edit on a public project", organizationmember.RoleMember, false, &edit, organization.DefaultProjectPermissionView, edit, true},
+		{"member of a private project", organizationmember.RoleMember, true, &view, organization.DefaultProjectPermissionEdit, view, true},
+		{"non-member of a private project", organizationmember.RoleMember, true, nil, organization.DefaultProjectPermissionEdit, "", false},
+	}
+	for _, tt := range tests {
+		org := &ent.Organization{DefaultProjectPermission: tt.orgDefault}
+		membership := &ent.OrganizationMember{Role: tt.role}
+		proj := &ent.Project{IsPrivate: tt.private}
+
+		got, access := projectPermission(org, membership, proj, tt.explicit)
+		if got != tt.want || access != tt.wantAccess {
+			t.Errorf("%s: (%q, %v), want (%q, %v)", tt.name, got, access, tt.want, tt.wantAccess)
+		}
+	}
+}
+
+func TestEffectiveProjectPermissionLoadsExplicitPermission(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	membership := addTestMember(t, client, org, member, organizationmember.RoleMember)
+	private := createTestProject(t, client, org, "Private", true)
+
+	if _, access, err := EffectiveProjectPermission(ctx, client, org, membership, private); err != nil || access {
+		t.Fatalf("before joining: access %v, error %v", access, err)
+	}
+
+	if err := client.ProjectMember.Create().
+		SetProjectID(private.ID).
+		SetUserID(member.ID).
+		SetPermission(projectmember.PermissionEdit).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	permission, access, err := EffectiveProjectPermission(ctx, client, org, membership, private)
+	if err != nil || !access || permission != string(projectmember.PermissionEdit) {
+		t.Errorf("after joining: (%q, %v, %v), want edit access", permission, access, err)
+	}
+}
+
+func TestOrganizationClaimOnlyAuthorizesReads(t *testing.T) {
+	t.Setenv("JWT_ORG_CLAIMS", "true")
+	ctx := context.Background()
+	client := newTestClient(t)
+	jwtService, err := auth.NewJWTService(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := createTestUser(t, client, "owner@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	member := createTestUser(t, client, "member@example.com")
+	m := addTestMember(t, client, org, member, organizationmember.RoleAdmin)
+	token, err := jwtService.GenerateAccessToken(member.ID, member.Email, &auth.OrganizationClaim{
+		ID:   org.ID,
+		Slug: org.Slug,
+		Role: string(organizationmember.RoleAdmin),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Demoted after the token was issued
+	if err := m.Update().SetRole(organizationmember.RoleMember).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	e.Any("/organizations/:slug", func(c echo.Context) error {
+		userID, _ := auth.GetUserID(c)
+		_, membership, err := loadOrgMembership(c.Request().Context(), client, userID, c.Param("slug"))
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, string(membership.Role))
+	}, auth.AuthMiddleware(jwtService))
+
+	for method, want := range map[string]string{
+		http.MethodGet:    "admin",
+		http.MethodPost:   "member",
+		http.MethodPatch:  "member",
+		http.MethodDelete: "member",
+	} {
+		req := httptest.NewRequest(method, "/organizations/acme", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK || rec.Body.String() != want {
+			t.Errorf("%s: status %d, role %q; want the %s role", method, rec.Code, rec.Body, want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"backend/ent"
+	"backend/ent/activity"
+	"backend/internal/auth"
+	"backend/internal/logging"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Activity actions
+const (
+	ActivityTaskCreated    = "task.created"
+	ActivityTaskUpdated    = "task.updated"
+	ActivityProjectCreated = "project.created"
+	ActivityMemberAdded    = "member.added"
+	ActivityMemberRemoved  = "member.removed"
+	ActivityInviteSent     = "invite.sent"
+)
+
+// Activity target types
+const (
+	ActivityTargetTask    = "task"
+	ActivityTargetProject = "project"
+	ActivityTargetUser    = "user"
+	ActivityTargetInvite  = "invite"
+)
+
+// activityEntry describes an action to record in an organization's activity feed
+type activityEntry struct {
+	OrgID      uuid.UUID
+	ActorID    uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   uuid.UUID
+	Metadata   map[string]any
+}
+
+// recordActivity writes an entry to the activity feed and queues it for subscribed webhooks in
+// the background, so the request that performed the action neither waits for nor fails because
+// of the writes
+func recordActivity(ctx context.Context, client *ent.Client, e activityEntry) {
+	requestID := logging.RequestID(ctx)
+
+	go func() {
+		// Detached from the request so that it can finish, but still logged under its id
+		ctx, cancel := context.WithTimeout(logging.WithRequestID(context.Background(), requestID), 10*time.Second)
+		defer cancel()
+
+		a, err := client.Activity.Create().
+			SetOrganizationID(e.OrgID).
+			SetActorID(e.ActorID).
+			SetAction(e.Action).
+			SetTargetType(e.TargetType).
+			SetTargetID(e.TargetID).
+			SetMetadata(e.Metadata).
+			Save(ctx)
+		if err != nil {
+			logging.FromContext(ctx).Error("failed to record activity", "action", e.Action, "target_type", e.TargetType, "target_id", e.TargetID, "error", err)
+			return
+		}
+
+		queueWebhookDeliveries(ctx, client, a)
+	}()
+}
+
+// projectActivityMetadata describes a project for the feed. The feed is readable by every
+// member of the organization, so the names of private projects are left out.
+func projectActivityMetadata(p *ent.Project) map[string]any {
+	metadata := map[string]any{
+		"project_id": p.ID,
+		"is_private": p.IsPrivate,
+	}
+	if !p.IsPrivate {
+		metadata["project_name"] = p.Name
+	}
+	return metadata
+}
+
+// taskActivityMetadata describes a task and its project for the feed. Task titles in private
+// projects are left out.
+func taskActivityMetadata(p *ent.Project, t *ent.Task) map[string]any {
+	metadata := projectActivityMetadata(p)
+	if !p.IsPrivate {
+		metadata["title"] = t.Title
+	}
+	return metadata
+}
+
+// inviteActivity describes an invite being sent
+func inviteActivity(orgID, inviterID uuid.UUID, inv *ent.Invite) activityEntry {
+	return activityEntry{
+		OrgID:      orgID,
+		ActorID:    inviterID,
+		Action:     ActivityInviteSent,
+		TargetType: ActivityTargetInvite,
+		TargetID:   inv.ID,
+		Metadata: map[string]any{
+			"email": inv.Email,
+			"role":  string(inv.Role),
+		},
+	}
+}
+
+// ActivityResponse represents an entry in an organization's activity feed
+type ActivityResponse struct {
+	ID         uuid.UUID        `json:"id"`
+	Action     string           `json:"action"`
+	TargetType string           `json:"target_type"`
+	TargetID   uuid.UUID        `json:"target_id"`
+	Metadata   map[string]any   `json:"metadata,omitempty"`
+	Actor      TaskUserResponse `json:"actor"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// ActivityListResponse represents a page of an organization's activity feed
+type ActivityListResponse struct {
+	Activities []ActivityResponse `json:"activities"`
+	NextCursor *string            `json:"next_cursor"`
+}
+
+var activitySortFields = SortFields{
+	"created_at": {Column: activity.FieldCreatedAt},
+}
+
+// ListActivity lists an organization's activity feed, newest first. Any member may read it.
+func (h *OrganizationHandler) ListActivity(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	spec, err := parseSort(c, activitySortFields, "-created_at")
+	if err != nil {
+		return err
+	}
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+	after, err := parseCursor(c, spec)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	org, _, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	query := h.client.Activity.Query().
+		Where(activity.OrganizationIDEQ(org.ID))
+	if after != nil {
+		query.Where(after)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	activities, err := query.
+		WithActor().
+		Order(spec.Order).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list activity")
+	}
+
+	var nextCursor *string
+	if len(activities) > limit {
+		activities = activities[:limit]
+		last := activities[len(activities)-1]
+		nextCursor = encodeCursor(spec, []any{last.CreatedAt}, last.ID)
+	}
+
+	result := make([]ActivityResponse, len(activities))
+	for i, a := range activities {
+		result[i] = ActivityResponse{
+			ID:         a.ID,
+			Action:     a.Action,
+			TargetType: a.TargetType,
+			TargetID:   a.TargetID,
+			Metadata:   a.Metadata,
+			CreatedAt:  a.CreatedAt,
+		}
+		if actor := a.Edges.Actor; actor != nil {
+			result[i].Actor = TaskUserResponse{
+				ID:          actor.ID,
+				DisplayName: actor.DisplayName,
+				Email:       actor.Email,
+			}
+		}
+	}
+
+	return c.JSON(http.StatusOK, ActivityListResponse{
+		Activities: result,
+		NextCursor: nextCursor,
+	})
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/apikey"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CreateAPIKeyRequest represents the request to create an API key. Scopes default to read only.
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required,min=1,max=100"`
+	Scopes []string `json:"scopes"`
+}
+
+// APIKeyResponse represents an API key. The key itself is only returned when it is created.
+type APIKeyResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	KeyPrefix   string     `json:"key_prefix"`
+	Key         string     `json:"key,omitempty"`
+	Scopes      []string   `json:"scopes"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedByID uuid.UUID  `json:"created_by_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func newAPIKeyResponse(k *ent.APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		ID:          k.ID,
+		Name:        k.Name,
+		KeyPrefix:   k.KeyPrefix,
+		Scopes:      k.Scopes,
+		LastUsedAt:  k.LastUsedAt,
+		CreatedByID: k.CreatedByID,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+// loadAPIKeyOrg resolves the organization for an API key route. Keys are managed by owners and
+// admins without read-only access, and only when signed in as themselves: a key cannot be used
+// to mint or revoke keys.
+func (h *OrganizationHandler) loadAPIKeyOrg(c echo.Context, ctx context.Context, userID uuid.UUID) (*ent.Organization, error) {
+	if err := refuseAPIKey(c); err != nil {
+		return nil, err
+	}
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return nil, err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "only owners and admins can manage api keys")
+	}
+	if err := requireWriteAccess(membership); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// CreateAPIKey creates an API key for the organization. The response is the only time the
+// plaintext key is shown.
+func (h *OrganizationHandler) CreateAPIKey(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if len(req.Scopes) == 0 {
+		req.Scopes = []string{auth.ScopeRead}
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(auth.Scopes, scope) {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown scope: "+scope+" (must be one of: "+strings.Join(auth.Scopes, ", ")+")")
+		}
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadAPIKeyOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate api key")
+	}
+
+	k, err := h.client.APIKey.Create().
+		SetOrganizationID(org.ID).
+		SetName(req.Name).
+		SetKeyPrefix(prefix).
+		SetHashedKey(hash).
+		SetScopes(slices.Compact(slices.Sorted(slices.Values(req.Scopes)))).
+		SetCreatedByID(userID).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create api key")
+	}
+
+	resp := newAPIKeyResponse(k)
+	resp.Key = key
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// ListAPIKeys lists the organization's API keys
+func (h *OrganizationHandler) ListAPIKeys(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadAPIKeyOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	keys, err := h.client.APIKey.Query().
+		Where(apikey.OrganizationIDEQ(org.ID)).
+		Order(ent.Asc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list api keys")
+	}
+
+	result := make([]APIKeyResponse, len(keys))
+	for i, k := range keys {
+		result[i] = newAPIKeyResponse(k)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RevokeAPIKey deletes an API key. Requests using it fail from then on.
+func (h *OrganizationHandler) RevokeAPIKey(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	keyID, err := uuid.Parse(c.Param("key_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid api key ID")
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadAPIKeyOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	n, err := h.client.APIKey.Delete().
+		Where(
+			apikey.IDEQ(keyID),
+			apikey.OrganizationIDEQ(org.ID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke api key")
+	}
+	if n == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "api key not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/ent/organization"
+	"backend/ent/organizationmember"
+	"backend/internal/auth"
+	"backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestAPIKeysRequireWriteAccess(t *testing.T) {
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewOrganizationHandler(client, emailService)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	admin := createTestUser(t, client, "admin@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	m := addTestMember(t, client, org, admin, organizationmember.RoleAdmin)
+
+	create := func() int {
+		t.Helper()
+		c, rec := newTestContext(t, testRequest{
+			Method: http.MethodPost,
+			Params: map[string]string{"slug": org.Slug},
+			Body:   CreateAPIKeyRequest{Name: "CI"},
+			UserID: admin.ID,
+		})
+		return statusOf(t, h.CreateAPIKey(c), rec)
+	}
+
+	if status := create(); status != http.StatusCreated {
+		t.Fatalf("admin: status %d, want %d", status, http.StatusCreated)
+	}
+	if err := m.Update().SetReadOnly(true).Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if status := create(); status != http.StatusForbidden {
+		t.Errorf("read-only admin: status %d, want %d", status, http.StatusForbidden)
+	}
+}
+
+func TestAPIKeysCannotTakeOwnerActions(t *testing.T) {
+	ctx := context.Background()
+	h, client, org, owner := newTestWebhookOrg(t)
+	jwtService, err := auth.NewJWTService(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.APIKey.Create().
+		SetOrganizationID(org.ID).
+		SetName("CI").
+		SetKeyPrefix(prefix).
+		SetHashedKey(hash).
+		SetScopes([]string{auth.ScopeRead, auth.ScopeWrite}).
+		SetCreatedByID(owner.ID).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	protected := e.Group("", auth.APIKeyMiddleware(auth.NewAPIKeys(client), jwtService))
+	protected.GET("/organizations/:slug", h.GetOrganization)
+	protected.DELETE("/organizations/:slug", h.DeleteOrganization)
+	protected.POST("/organizations/:slug/webhooks", h.CreateWebhook)
+
+	tests := []struct {
+		method, path, body string
+		want               int
+	}{
+		{http.MethodGet, "/organizations/acme", "", http.StatusOK},
+		{http.MethodDelete, "/organizations/acme", "", http.StatusForbidden},
+		{http.MethodPost, "/organizations/acme/webhooks", `{"url":"https://example.com/hook","events":["task.created"]}`, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+		req.Header.Set(echo.HeaderAuthorization, "ApiKey "+key)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("%s %s: status %d, want %d: %s", tt.method, tt.path, rec.Code, tt.want, rec.Body)
+		}
+	}
+
+	if exists, err := client.Organization.Query().Where(organization.IDEQ(org.ID)).Exist(ctx); err != nil || !exists {
+		t.Errorf("organization deleted through an api key")
+	}
+	if n, err := client.Webhook.Query().Count(ctx); err != nil || n != 0 {
+		t.Errorf("%d webhooks created through an api key", n)
+	}
+}
@@ -0,0 +1,337 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/attachment"
+	"backend/internal/auth"
+	"backend/internal/logging"
+	"backend/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// defaultMaxAttachmentSize is the largest file accepted unless ATTACHMENT_MAX_SIZE says otherwise
+	defaultMaxAttachmentSize = 10 << 20
+	// attachmentURLExpiry is how long presigned upload and download URLs stay valid
+	attachmentURLExpiry = 15 * time.Minute
+)
+
+// RequestAttachmentUploadRequest represents the request for a presigned upload URL
+type RequestAttachmentUploadRequest struct {
+	Filename    string `json:"filename" validate:"required,max=255"`
+	ContentType string `json:"content_type" validate:"required,max=255"`
+	Size        int64  `json:"size" validate:"required,min=1"`
+}
+
+// AttachmentUploadResponse tells the client where to upload a file. The file must be sent
+// with a PUT to upload_url carrying the given headers, and then registered with
+// CreateAttachment using storage_key.
+type AttachmentUploadResponse struct {
+	UploadURL  string            `json:"upload_url"`
+	Method     string            `json:"method"`
+	Headers    map[string]string `json:"headers"`
+	StorageKey string            `json:"storage_key"`
+	ExpiresAt  time.Time         `json:"expires_at"`
+}
+
+// CreateAttachmentRequest represents the request to register an uploaded file on a task
+type CreateAttachmentRequest struct {
+	StorageKey  string `json:"storage_key" validate:"required"`
+	Filename    string `json:"filename" validate:"required,max=255"`
+	ContentType string `json:"content_type" validate:"required,max=255"`
+}
+
+// AttachmentResponse represents a file attached to a task
+type AttachmentResponse struct {
+	ID          uuid.UUID        `json:"id"`
+	TaskID      uuid.UUID        `json:"task_id"`
+	Filename    string           `json:"filename"`
+	ContentType string           `json:"content_type"`
+	Size        int64            `json:"size"`
+	Uploader    TaskUserResponse `json:"uploader"`
+	DownloadURL string           `json:"download_url"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+func (h *TaskHandler) newAttachmentResponse(c echo.Context, a *ent.Attachment, uploader *ent.User) (AttachmentResponse, error) {
+	downloadURL, err := h.storage.PresignDownload(c.Request().Context(), a.StorageKey, a.Filename, attachmentURLExpiry)
+	if err != nil {
+		return AttachmentResponse{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to sign download url").SetInternal(err)
+	}
+
+	return AttachmentResponse{
+		ID:          a.ID,
+		TaskID:      a.TaskID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Uploader: TaskUserResponse{
+			ID:          uploader.ID,
+			DisplayName: uploader.DisplayName,
+			Email:       uploader.Email,
+		},
+		DownloadURL: downloadURL,
+		CreatedAt:   a.CreatedAt,
+	}, nil
+}
+
+// requireStorage rejects attachment requests when no storage backend is configured
+func (h *TaskHandler) requireStorage() error {
+	if h.storage == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "attachments are not enabled")
+	}
+	return nil
+}
+
+// attachmentKeyPrefix is the storage key prefix of a task's files. Keys are generated by the
+// server, so the prefix ties a key presented for finalizing back to the task it was issued for.
+func attachmentKeyPrefix(taskID uuid.UUID) string {
+	return fmt.Sprintf("tasks/%s/", taskID)
+}
+
+// cleanFilename drops any directory part a client sent with a filename
+func cleanFilename(name string) string {
+	return strings.TrimSpace(path.Base(strings.ReplaceAll(name, `\`, "/")))
+}
+
+// RequestAttachmentUpload returns a presigned URL the client uploads a file to directly.
+// Only metadata passes through the API; the file is registered with CreateAttachment once
+// the upload has finished.
+func (h *TaskHandler) RequestAttachmentUpload(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	if err := h.requireStorage(); err != nil {
+		return err
+	}
+
+	var req RequestAttachmentUploadRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if req.Size > h.maxAttachmentSize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("files can be at most %d bytes", h.maxAttachmentSize))
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	key := attachmentKeyPrefix(t.ID) + uuid.NewString()
+	uploadURL, headers, err := h.storage.PresignUpload(ctx, key, req.ContentType, req.Size, attachmentURLExpiry)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to sign upload url").SetInternal(err)
+	}
+
+	return c.JSON(http.StatusOK, AttachmentUploadResponse{
+		UploadURL:  uploadURL,
+		Method:     http.MethodPut,
+		Headers:    headers,
+		StorageKey: key,
+		ExpiresAt:  time.Now().Add(attachmentURLExpiry),
+	})
+}
+
+// CreateAttachment registers a file uploaded through RequestAttachmentUpload on the task. The
+// size is read back from storage rather than trusted from the client.
+func (h *TaskHandler) CreateAttachment(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	if err := h.requireStorage(); err != nil {
+		return err
+	}
+
+	var req CreateAttachmentRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.Filename = cleanFilename(req.Filename)
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(req.StorageKey, attachmentKeyPrefix(t.ID)) {
+		return echo.NewHTTPError(http.StatusBadRequest, "storage_key was not issued for this task")
+	}
+
+	size, err := h.storage.Size(ctx, req.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusBadRequest, "file has not been uploaded")
+		}
+		return echo.NewHTTPError(http.StatusBadGateway, "failed to check uploaded file").SetInternal(err)
+	}
+	if size > h.maxAttachmentSize {
+		if err := h.storage.Delete(ctx, req.StorageKey); err != nil {
+			logging.FromContext(ctx).Error("failed to delete oversized upload", "storage_key", req.StorageKey, "error", err)
+		}
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("files can be at most %d bytes", h.maxAttachmentSize))
+	}
+
+	a, err := h.client.Attachment.Create().
+		SetTaskID(t.ID).
+		SetUploaderID(userID).
+		SetFilename(req.Filename).
+		SetContentType(req.ContentType).
+		SetSize(size).
+		SetStorageKey(req.StorageKey).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "file is already attached")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create attachment")
+	}
+
+	uploader, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+
+	resp, err := h.newAttachmentResponse(c, a, uploader)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// ListAttachments lists the files attached to a task, oldest first, with short-lived download URLs
+func (h *TaskHandler) ListAttachments(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	if err := h.requireStorage(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	attachments, err := h.client.Attachment.Query().
+		Where(attachment.TaskIDEQ(t.ID)).
+		WithUploader().
+		Order(ent.Asc(attachment.FieldCreatedAt, attachment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachments")
+	}
+
+	result := make([]AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		result[i], err = h.newAttachmentResponse(c, a, a.Edges.Uploader)
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteAttachment removes a file from a task and from storage
+func (h *TaskHandler) DeleteAttachment(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	if err := h.requireStorage(); err != nil {
+		return err
+	}
+
+	attachmentID, err := uuid.Parse(c.Param("attachment_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid attachment_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	a, err := h.client.Attachment.Query().
+		Where(
+			attachment.IDEQ(attachmentID),
+			attachment.TaskIDEQ(t.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "attachment not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment")
+	}
+
+	if err := h.client.Attachment.DeleteOneID(a.ID).Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete attachment")
+	}
+
+	// The metadata is gone either way; a leftover object is only wasted space
+	if err := h.storage.Delete(ctx, a.StorageKey); err != nil {
+		logging.FromContext(ctx).Error("failed to delete attachment file", "storage_key", a.StorageKey, "error", err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
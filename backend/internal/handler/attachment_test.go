@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/internal/service"
+	"backend/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// fakeStore is a storage.Store holding object sizes in memory
+type fakeStore struct {
+	sizes   map[string]int64
+	deleted []string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{sizes: make(map[string]int64)}
+}
+
+func (s *fakeStore) PresignUpload(_ context.Context, key, contentType string, _ int64, _ time.Duration) (string, map[string]string, error) {
+	return "https://storage.example.com/" + key, map[string]string{"Content-Type": contentType}, nil
+}
+
+func (s *fakeStore) PresignDownload(_ context.Context, key, _ string, _ time.Duration) (string, error) {
+	return "https://storage.example.com/" + key, nil
+}
+
+func (s *fakeStore) Size(_ context.Context, key string) (int64, error) {
+	size, ok := s.sizes[key]
+	if !ok {
+		return 0, storage.ErrNotFound
+	}
+	return size, nil
+}
+
+func (s *fakeStore) Delete(_ context.Context, key string) error {
+	delete(s.sizes, key)
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+// attachmentTest is a task in a public project of an organization with an owner, who can
+// edit it, and a member, who can only view it
+type attachmentTest struct {
+	h      *TaskHandler
+	client *ent.Client
+	store  *fakeStore
+	owner  *ent.User
+	viewer *ent.User
+	params map[string]string
+	task   *ent.Task
+}
+
+func newAttachmentTest(t *testing.T, store storage.Store) *attachmentTest {
+	t.Helper()
+	t.Setenv("ATTACHMENT_MAX_SIZE", "100")
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owner := createTestUser(t, client, "owner@example.com")
+	viewer := createTestUser(t, client, "viewer@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	addTestMember(t, client, org, viewer, organizationmember.RoleMember)
+	proj := createTestProject(t, client, org, "Project", false)
+	task, err := client.Task.Create().
+		SetProjectID(proj.ID).
+		SetTitle("Task").
+		SetCreatedByID(owner.ID).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := &attachmentTest{
+		h:      NewTaskHandler(client, emailService, store),
+		client: client,
+		owner:  owner,
+		viewer: viewer,
+		params: map[string]string{"slug": org.Slug, "project_id": proj.ID.String(), "task_id": task.ID.String()},
+		task:   task,
+	}
+	at.store, _ = store.(*fakeStore)
+	return at
+}
+
+// create registers the file at key on the task as u
+func (at *attachmentTest) create(t *testing.T, u *ent.User, key string) (int, string) {
+	t.Helper()
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: at.params,
+		Body:   CreateAttachmentRequest{StorageKey: key, Filename: "../notes.txt", ContentType: "text/plain"},
+		UserID: u.ID,
+	})
+	return statusOf(t, at.h.CreateAttachment(c), rec), rec.Body.String()
+}
+
+func TestAttachmentUploadAndCreate(t *testing.T) {
+	at := newAttachmentTest(t, newFakeStore())
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: at.params,
+		Body:   RequestAttachmentUploadRequest{Filename: "notes.txt", ContentType: "text/plain", Size: 10},
+		UserID: at.owner.ID,
+	})
+	if status := statusOf(t, at.h.RequestAttachmentUpload(c), rec); status != http.StatusOK {
+		t.Fatalf("request upload: status %d, want %d: %s", status, http.StatusOK, rec.Body.String())
+	}
+	var upload AttachmentUploadResponse
+	decodeResponse(t, rec, &upload)
+	if !strings.HasPrefix(upload.StorageKey, attachmentKeyPrefix(at.task.ID)) {
+		t.Fatalf("storage key %q is not under the task's prefix", upload.StorageKey)
+	}
+
+	// Registering before the upload finished fails
+	if status, body := at.create(t, at.owner, upload.StorageKey); status != http.StatusBadRequest {
+		t.Errorf("create before upload: status %d, want %d: %s", status, http.StatusBadRequest, body)
+	}
+
+	at.store.sizes[upload.StorageKey] = 42
+	status, body := at.create(t, at.owner, upload.StorageKey)
+	if status != http.StatusCreated {
+		t.Fatalf("create: status %d, want %d: %s", status, http.StatusCreated, body)
+	}
+	a := at.client.Attachment.Query().OnlyX(context.Background())
+	if a.Size != 42 || a.Filename != "notes.txt" || a.StorageKey != upload.StorageKey {
+		t.Errorf("attachment %q of %d bytes at %q, want notes.txt of 42 bytes at %q", a.Filename, a.Size, a.StorageKey, upload.StorageKey)
+	}
+}
+
+func TestCreateAttachmentChecksStorageKey(t *testing.T) {
+	at := newAttachmentTest(t, newFakeStore())
+
+	// Keys issued for another task, or outside any task, can't be claimed
+	for _, key := range []string{
+		attachmentKeyPrefix(uuid.New()) + uuid.NewString(),
+		"avatars/" + uuid.NewString(),
+		"tasks/" + at.task.ID.String() + uuid.NewString(),
+	} {
+		at.store.sizes[key] = 10
+		if status, body := at.create(t, at.owner, key); status != http.StatusBadRequest {
+			t.Errorf("key %q: status %d, want %d: %s", key, status, http.StatusBadRequest, body)
+		}
+	}
+	if n := at.client.Attachment.Query().CountX(context.Background()); n != 0 {
+		t.Errorf("%d attachments created, want none", n)
+	}
+}
+
+func TestCreateAttachmentDeletesOversizedUpload(t *testing.T) {
+	at := newAttachmentTest(t, newFakeStore())
+
+	key := attachmentKeyPrefix(at.task.ID) + uuid.NewString()
+	at.store.sizes[key] = 101
+	if status, body := at.create(t, at.owner, key); status != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status %d, want %d: %s", status, http.StatusRequestEntityTooLarge, body)
+	}
+	if len(at.store.deleted) != 1 || at.store.deleted[0] != key {
+		t.Errorf("deleted %v, want the oversized upload %s", at.store.deleted, key)
+	}
+	if n := at.client.Attachment.Query().CountX(context.Background()); n != 0 {
+		t.Errorf("%d attachments created, want none", n)
+	}
+
+	// Sizes declared over the limit are refused before a URL is signed
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: at.params,
+		Body:   RequestAttachmentUploadRequest{Filename: "big.bin", ContentType: "application/octet-stream", Size: 101},
+		UserID: at.owner.ID,
+	})
+	if status := statusOf(t, at.h.RequestAttachmentUpload(c), rec); status != http.StatusRequestEntityTooLarge {
+		t.Errorf("request upload: status %d, want %d", status, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestAttachmentsRequireEditPermission(t *testing.T) {
+	ctx := context.Background()
+	at := newAttachmentTest(t, newFakeStore())
+
+	key := attachmentKeyPrefix(at.task.ID) + uuid.NewString()
+	at.store.sizes[key] = 10
+	if status, body := at.create(t, at.owner, key); status != http.StatusCreated {
+		t.Fatalf("owner create: status %d, want %d: %s", status, http.StatusCreated, body)
+	}
+	a := at.client.Attachment.Query().OnlyX(ctx)
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: at.params,
+		Body:   RequestAttachmentUploadRequest{Filename: "notes.txt", ContentType: "text/plain", Size: 10},
+		UserID: at.viewer.ID,
+	})
+	if status := statusOf(t, at.h.RequestAttachmentUpload(c), rec); status != http.StatusForbidden {
+		t.Errorf("viewer request upload: status %d, want %d", status, http.StatusForbidden)
+	}
+
+	other := attachmentKeyPrefix(at.task.ID) + uuid.NewString()
+	at.store.sizes[other] = 10
+	if status, body := at.create(t, at.viewer, other); status != http.StatusForbidden {
+		t.Errorf("viewer create: status %d, want %d: %s", status, http.StatusForbidden, body)
+	}
+
+	deleteParams := map[string]string{"attachment_id": a.ID.String()}
+	for k, v := range at.params {
+		deleteParams[k] = v
+	}
+	c, rec = newTestContext(t, testRequest{Method: http.MethodDelete, Params: deleteParams, UserID: at.viewer.ID})
+	if status := statusOf(t, at.h.DeleteAttachment(c), rec); status != http.StatusForbidden {
+		t.Errorf("viewer delete: status %d, want %d", status, http.StatusForbidden)
+	}
+	if len(at.store.deleted) != 0 {
+		t.Errorf("deleted %v after refused requests, want nothing", at.store.deleted)
+	}
+
+	// Viewers can still list and download
+	c, rec = newTestContext(t, testRequest{Params: at.params, UserID: at.viewer.ID})
+	if status := statusOf(t, at.h.ListAttachments(c), rec); status != http.StatusOK {
+		t.Fatalf("viewer list: status %d, want %d", status, http.StatusOK)
+	}
+	var list []AttachmentResponse
+	decodeResponse(t, rec, &list)
+	if len(list) != 1 || list[0].ID != a.ID || list[0].DownloadURL == "" {
+		t.Errorf("listed %+v, want the attachment with a download url", list)
+	}
+
+	c, rec = newTestContext(t, testRequest{Method: http.MethodDelete, Params: deleteParams, UserID: at.owner.ID})
+	if status := statusOf(t, at.h.DeleteAttachment(c), rec); status != http.StatusNoContent {
+		t.Fatalf("owner delete: status %d, want %d", status, http.StatusNoContent)
+	}
+	if len(at.store.deleted) != 1 || at.store.deleted[0] != key {
+		t.Errorf("deleted %v, want %s", at.store.deleted, key)
+	}
+}
+
+func TestAttachmentsWithoutStorage(t *testing.T) {
+	at := newAttachmentTest(t, nil)
+
+	params := map[string]string{"attachment_id": uuid.NewString()}
+	for k, v := range at.params {
+		params[k] = v
+	}
+	tests := []struct {
+		name    string
+		method  string
+		body    any
+		handler func(echo.Context) error
+	}{
+		{"request upload", http.MethodPost, RequestAttachmentUploadRequest{Filename: "notes.txt", ContentType: "text/plain", Size: 10}, at.h.RequestAttachmentUpload},
+		{"create", http.MethodPost, CreateAttachmentRequest{StorageKey: attachmentKeyPrefix(at.task.ID) + uuid.NewString(), Filename: "notes.txt", ContentType: "text/plain"}, at.h.CreateAttachment},
+		{"list", http.MethodGet, nil, at.h.ListAttachments},
+		{"delete", http.MethodDelete, nil, at.h.DeleteAttachment},
+	}
+	for _, tt := range tests {
+		c, rec := newTestContext(t, testRequest{Method: tt.method, Params: params, Body: tt.body, UserID: at.owner.ID})
+		if status := statusOf(t, tt.handler(c), rec); status != http.StatusServiceUnavailable {
+			t.Errorf("%s: status %d, want %d", tt.name, status, http.StatusServiceUnavailable)
+		}
+	}
+}
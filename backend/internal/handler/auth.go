@@ -2,42 +2,153 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"backend/ent"
+	"backend/ent/apikey"
+	"backend/ent/invite"
+	"backend/ent/notification"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/ent/taskwatcher"
 	"backend/ent/user"
 	"backend/internal/auth"
+	"backend/internal/logging"
+	"backend/internal/ratelimit"
 	"backend/internal/service"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // validate is the validator instance
-var validate = validator.New()
-
-// formatValidationError formats validation errors into a user-friendly message
-func formatValidationError(err error) string {
-	if validationErrors, ok := err.(validator.ValidationErrors); ok {
-		for _, e := range validationErrors {
-			field := e.Field()
-			switch e.Tag() {
-			case "required":
-				return field + " is required"
-			case "email":
-				return field + " must be a valid email address"
-			case "min":
-				return field + " must be at least " + e.Param() + " characters"
-			case "max":
-				return field + " must be at most " + e.Param() + " characters"
-			default:
-				return field + " is invalid"
+var validate = newValidator()
+
+// passwordPolicy is the policy new passwords are checked against, read from the environment on first use
+var passwordPolicy = sync.OnceValue(auth.PasswordPolicyFromEnv)
+
+// newValidator creates a validator that names fields by their JSON names, so errors refer to
+// fields the way clients send them
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+		return name
+	})
+	// "password" applies the password policy; the formatter reports each rule the value breaks
+	v.RegisterValidation("password", func(fl validator.FieldLevel) bool {
+		return len(passwordPolicy().Violations(fl.Field().String())) == 0
+	})
+	return v
+}
+
+// formatValidationError formats every failed field of a validation error into a
+// user-friendly message
+func formatValidationError(err error) []FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fields := make([]FieldError, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		// The namespace starts with the request type; nested fields keep their path, e.g. set.status
+		_, field, _ := strings.Cut(e.Namespace(), ".")
+		name := e.Field()
+		if e.Tag() == "password" {
+			for _, violation := range passwordPolicy().Violations(fmt.Sprint(e.Value())) {
+				fields = append(fields, FieldError{Field: field, Message: name + " " + violation})
 			}
+			continue
 		}
+
+		var message string
+		switch e.Tag() {
+		case "required":
+			message = name + " is required"
+		case "email":
+			message = name + " must be a valid email address"
+		case "min":
+			message = name + " must be at least " + e.Param() + " characters"
+		case "max":
+			message = name + " must be at most " + e.Param() + " characters"
+		default:
+			message = name + " is invalid"
+		}
+		fields = append(fields, FieldError{Field: field, Message: message})
+	}
+	return fields
+}
+
+// validationMessage joins the messages of the failed fields into one
+func validationMessage(fields []FieldError) string {
+	if len(fields) == 0 {
+		return "validation failed"
+	}
+	messages := make([]string, len(fields))
+	for i, f := range fields {
+		messages[i] = f.Message
 	}
-	return "validation failed"
+	return strings.Join(messages, "; ")
+}
+
+// normalizeEmail canonicalizes an email address before it is stored or matched.
+// Addresses are trimmed and lowercased as a whole; mailbox names are case-insensitive
+// in practice with every provider we care about, and treating them that way prevents
+// duplicate accounts such as "User@Example.com" and "user@example.com".
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// requestLocale picks the email locale from the request's Accept-Language header
+func requestLocale(c echo.Context) string {
+	return service.LocaleFromAcceptLanguage(c.Request().Header.Get("Accept-Language"))
+}
+
+// isValidTimezone reports whether name is an IANA time zone name that can be loaded
+func isValidTimezone(name string) bool {
+	// "Local" depends on the server configuration, so it is not accepted as a user setting
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// defaultTimezone returns the timezone assigned to new users (APP_TIMEZONE, or UTC)
+func defaultTimezone() string {
+	if tz := os.Getenv("APP_TIMEZONE"); isValidTimezone(tz) {
+		return tz
+	}
+	return "UTC"
+}
+
+// userLocation loads a user's time zone, falling back to UTC if the stored name no longer loads
+func userLocation(ctx context.Context, client *ent.Client, userID uuid.UUID) (*time.Location, error) {
+	u, err := client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(u.Timezone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return loc, nil
 }
 
 // AuthHandler handles authentication-related requests
@@ -45,21 +156,24 @@ type AuthHandler struct {
 	client       *ent.Client
 	jwtService   *auth.JWTService
 	emailService *service.EmailService
+	attempts     *ratelimit.AttemptLimiter
 }
 
-// NewAuthHandler creates a new auth handler
-func NewAuthHandler(client *ent.Client, jwtService *auth.JWTService, emailService *service.EmailService) *AuthHandler {
+// NewAuthHandler creates a new auth handler. attempts limits failed logins per IP and per
+// email, and registrations per IP.
+func NewAuthHandler(client *ent.Client, jwtService *auth.JWTService, emailService *service.EmailService, attempts *ratelimit.AttemptLimiter) *AuthHandler {
 	return &AuthHandler{
 		client:       client,
 		jwtService:   jwtService,
 		emailService: emailService,
+		attempts:     attempts,
 	}
 }
 
 // RegisterRequest represents the registration request body
 type RegisterRequest struct {
 	Email       string `json:"email" validate:"required,email"`
-	Password    string `json:"password" validate:"required,min=8"`
+	Password    string `json:"password" validate:"required,password"`
 	DisplayName string `json:"display_name" validate:"required,min=1,max=100"`
 }
 
@@ -87,28 +201,57 @@ type UserResponse struct {
 	ID            uuid.UUID  `json:"id"`
 	Email         string     `json:"email"`
 	DisplayName   string     `json:"display_name"`
+	Timezone      string     `json:"timezone"`
 	LastOrgID     *uuid.UUID `json:"last_org_id,omitempty"`
 	LastProjectID *uuid.UUID `json:"last_project_id,omitempty"`
 	CreatedAt     time.Time  `json:"created_at"`
 }
 
+// newUserResponse builds the user data returned by the API
+func newUserResponse(u *ent.User) UserResponse {
+	return UserResponse{
+		ID:            u.ID,
+		Email:         u.Email,
+		DisplayName:   u.DisplayName,
+		Timezone:      u.Timezone,
+		LastOrgID:     u.LastOrgID,
+		LastProjectID: u.LastProjectID,
+		CreatedAt:     u.CreatedAt,
+	}
+}
+
 // Register handles user registration
 func (h *AuthHandler) Register(c echo.Context) error {
 	var req RegisterRequest
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
+	req.Email = normalizeEmail(req.Email)
 
 	// Validate request using validator
 	if err := validate.Struct(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, formatValidationError(err))
+		return validationError(err)
 	}
 
 	ctx := c.Request().Context()
 
-	// Check if user already exists
+	// Every registration attempt counts against the client's IP
+	now := time.Now()
+	registerKey := "register:ip:" + c.RealIP()
+	wait, err := h.attempts.Check(ctx, now, registerKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check registration attempts")
+	}
+	if wait > 0 {
+		return ratelimit.TooManyAttempts(c, wait, "too many registration attempts, please try again later")
+	}
+	if err := h.attempts.Record(ctx, now, registerKey); err != nil {
+		logging.FromContext(ctx).Error("failed to record registration attempt", "error", err)
+	}
+
+	// Check if user already exists (case-insensitively, to catch accounts created before normalization)
 	exists, err := h.client.User.Query().
-		Where(user.EmailEQ(req.Email)).
+		Where(user.EmailEqualFold(req.Email)).
 		Exist(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check user existence")
@@ -128,29 +271,25 @@ func (h *AuthHandler) Register(c echo.Context) error {
 		SetEmail(req.Email).
 		SetPasswordHash(passwordHash).
 		SetDisplayName(req.DisplayName).
+		SetTimezone(defaultTimezone()).
 		Save(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create user")
 	}
 
 	// Generate tokens
-	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, u.DisplayName)
+	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, nil)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate tokens")
 	}
 
-	// Send welcome email (non-blocking)
-	go func() {
-		_ = h.emailService.SendWelcomeEmail(context.Background(), u.Email, u.DisplayName)
-	}()
+	// Queue the welcome email; the account exists either way
+	if err := h.emailService.SendWelcomeEmail(ctx, requestLocale(c), u.Email, u.DisplayName); err != nil {
+		logging.FromContext(ctx).Error("failed to send welcome email", "user_id", u.ID, "error", err)
+	}
 
 	return c.JSON(http.StatusCreated, AuthResponse{
-		User: UserResponse{
-			ID:          u.ID,
-			Email:       u.Email,
-			DisplayName: u.DisplayName,
-			CreatedAt:   u.CreatedAt,
-		},
+		User:         newUserResponse(u),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresIn:    tokens.ExpiresIn,
@@ -163,51 +302,126 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
+	req.Email = normalizeEmail(req.Email)
 
 	// Validate request using validator
 	if err := validate.Struct(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, formatValidationError(err))
+		return validationError(err)
 	}
 
 	ctx := c.Request().Context()
 
+	// Failed logins are limited per IP and per email to slow down password guessing
+	now := time.Now()
+	emailKey := "login:email:" + req.Email
+	ipKey := "login:ip:" + c.RealIP()
+	wait, err := h.attempts.Check(ctx, now, emailKey, ipKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check login attempts")
+	}
+	if wait > 0 {
+		return ratelimit.TooManyAttempts(c, wait, "too many failed login attempts, please try again later")
+	}
+
 	// Find user by email
 	u, err := h.client.User.Query().
-		Where(user.EmailEQ(req.Email)).
+		Where(user.EmailEqualFold(req.Email)).
 		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusUnauthorized, "invalid email or password")
-		}
+	if err != nil && !ent.IsNotFound(err) {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
 	}
 
 	// Verify password
-	if !auth.CheckPassword(req.Password, u.PasswordHash) {
+	if u == nil || !auth.CheckPassword(req.Password, u.PasswordHash) {
+		if err := h.attempts.Record(ctx, now, emailKey, ipKey); err != nil {
+			logging.FromContext(ctx).Error("failed to record login attempt", "error", err)
+		}
 		return echo.NewHTTPError(http.StatusUnauthorized, "invalid email or password")
 	}
 
+	if err := h.attempts.Reset(ctx, emailKey); err != nil {
+		logging.FromContext(ctx).Error("failed to reset login attempts", "error", err)
+	}
+
+	// Hashes made before the cost was raised are upgraded while the password is at hand.
+	// Logging in doesn't depend on it, so failures are only logged.
+	if needsRehash(u.PasswordHash) {
+		if err := h.rehashPassword(ctx, u, req.Password); err != nil {
+			logging.FromContext(ctx).Error("failed to rehash password", "user_id", u.ID, "error", err)
+		}
+	}
+
 	// Generate tokens
-	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, u.DisplayName)
+	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, tokenOrganization(ctx, h.client, h.jwtService, u))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate tokens")
 	}
 
 	return c.JSON(http.StatusOK, AuthResponse{
-		User: UserResponse{
-			ID:            u.ID,
-			Email:         u.Email,
-			DisplayName:   u.DisplayName,
-			LastOrgID:     u.LastOrgID,
-			LastProjectID: u.LastProjectID,
-			CreatedAt:     u.CreatedAt,
-		},
+		User:         newUserResponse(u),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresIn:    tokens.ExpiresIn,
 	})
 }
 
+// needsRehash reports whether a password hash was made with a lower bcrypt cost than the
+// configured one
+func needsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err == nil && cost < auth.PasswordCost()
+}
+
+// rehashPassword stores a new hash of the user's password at the configured cost. Unlike a
+// password change it keeps existing sessions, and it leaves the hash alone if the password
+// was changed in the meantime.
+func (h *AuthHandler) rehashPassword(ctx context.Context, u *ent.User, password string) error {
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.client.User.Update().
+		Where(
+			user.IDEQ(u.ID),
+			user.PasswordHashEQ(u.PasswordHash),
+		).
+		SetPasswordHash(passwordHash).
+		Save(ctx)
+	return err
+}
+
+// tokenOrganization returns the organization claim for a user's access token: their last
+// organization, while they are still a member of it. It is nil when organization claims are
+// disabled or there is nothing to embed; failing to load it only costs the fast path, so
+// errors are logged rather than returned.
+func tokenOrganization(ctx context.Context, client *ent.Client, jwtService *auth.JWTService, u *ent.User) *auth.OrganizationClaim {
+	if !jwtService.OrganizationClaims() || u.LastOrgID == nil {
+		return nil
+	}
+
+	membership, err := client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(u.ID),
+			organizationmember.OrganizationIDEQ(*u.LastOrgID),
+		).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			logging.FromContext(ctx).Error("failed to load organization claim", "user_id", u.ID, "error", err)
+		}
+		return nil
+	}
+
+	return &auth.OrganizationClaim{
+		ID:       membership.OrganizationID,
+		Slug:     membership.Edges.Organization.Slug,
+		Role:     string(membership.Role),
+		ReadOnly: membership.ReadOnly,
+	}
+}
+
 // RefreshToken handles token refresh
 func (h *AuthHandler) RefreshToken(c echo.Context) error {
 	var req RefreshRequest
@@ -217,41 +431,178 @@ func (h *AuthHandler) RefreshToken(c echo.Context) error {
 
 	// Validate request using validator
 	if err := validate.Struct(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, formatValidationError(err))
+		return validationError(err)
 	}
 
-	// Validate refresh token
-	userID, err := h.jwtService.ValidateRefreshToken(req.RefreshToken)
+	ctx := c.Request().Context()
+
+	// Consume the refresh token; each one can be exchanged only once
+	claims, err := h.jwtService.RotateRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrReusedToken) {
+			logging.FromContext(ctx).Warn("refresh token reused, revoking its family")
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired refresh token")
+		}
+		if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrExpiredToken) || errors.Is(err, auth.ErrRevokedToken) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired refresh token")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to validate refresh token")
+	}
+
+	// Get user
+	u, err := h.client.User.Get(ctx, claims.UserID)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusUnauthorized, "user not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
+	}
+	if u.DeletedAt != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "user not found")
+	}
+
+	// Sessions started before the last password change are ended (iat has second precision)
+	if u.PasswordChangedAt != nil && claims.IssuedAt.Before(u.PasswordChangedAt.Truncate(time.Second)) {
 		return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired refresh token")
 	}
 
+	// Generate new tokens
+	tokens, err := h.jwtService.GenerateRotatedTokenPair(claims, u.Email, tokenOrganization(ctx, h.client, h.jwtService, u))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate tokens")
+	}
+
+	return c.JSON(http.StatusOK, AuthResponse{
+		User:         newUserResponse(u),
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    tokens.ExpiresIn,
+	})
+}
+
+// Logout revokes the presented refresh token. It is idempotent and returns 204
+// even when the token was already revoked, expired or invalid.
+func (h *AuthHandler) Logout(c echo.Context) error {
+	var req RefreshRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := validate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	if err := h.jwtService.RevokeRefreshToken(c.Request().Context(), req.RefreshToken); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke refresh token")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// IntrospectRequest represents the token introspection request body
+type IntrospectRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// IntrospectResponse describes an access token. Only Active is set for tokens that are
+// invalid or expired.
+type IntrospectResponse struct {
+	Active    bool       `json:"active"`
+	UserID    *uuid.UUID `json:"user_id,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Introspect reports whether an access token is valid, for gateways and tests that need to
+// check tokens without parsing them. It accepts exactly the tokens AuthMiddleware accepts.
+// Nothing from an invalid token is returned, since its claims can't be trusted.
+func (h *AuthHandler) Introspect(c echo.Context) error {
+	var req IntrospectRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := validate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	claims, err := h.jwtService.ValidateAccessToken(req.Token)
+	if err != nil {
+		return c.JSON(http.StatusOK, IntrospectResponse{Active: false})
+	}
+
+	resp := IntrospectResponse{
+		Active: true,
+		UserID: &claims.UserID,
+		Email:  claims.Email,
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = &claims.ExpiresAt.Time
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ChangePasswordRequest represents the request to change the current user's password
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,password"`
+}
+
+// ChangePassword changes the current user's password. Other sessions are ended and
+// a fresh token pair is returned for the caller.
+func (h *AuthHandler) ChangePassword(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req ChangePasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := validate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
 	ctx := c.Request().Context()
 
-	// Get user
 	u, err := h.client.User.Get(ctx, userID)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusUnauthorized, "user not found")
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
 		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+
+	if !auth.CheckPassword(req.CurrentPassword, u.PasswordHash) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "current password is incorrect")
+	}
+
+	passwordHash, err := auth.HashPassword(req.NewPassword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to hash password")
+	}
+
+	u, err = u.Update().
+		SetPasswordHash(passwordHash).
+		SetPasswordChangedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update password")
 	}
 
 	// Generate new tokens
-	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, u.DisplayName)
+	tokens, err := h.jwtService.GenerateTokenPair(u.ID, u.Email, tokenOrganization(ctx, h.client, h.jwtService, u))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate tokens")
 	}
 
 	return c.JSON(http.StatusOK, AuthResponse{
-		User: UserResponse{
-			ID:            u.ID,
-			Email:         u.Email,
-			DisplayName:   u.DisplayName,
-			LastOrgID:     u.LastOrgID,
-			LastProjectID: u.LastProjectID,
-			CreatedAt:     u.CreatedAt,
-		},
+		User:         newUserResponse(u),
 		AccessToken:  tokens.AccessToken,
 		RefreshToken: tokens.RefreshToken,
 		ExpiresIn:    tokens.ExpiresIn,
@@ -275,14 +626,7 @@ func (h *AuthHandler) GetMe(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
 	}
 
-	return c.JSON(http.StatusOK, UserResponse{
-		ID:            u.ID,
-		Email:         u.Email,
-		DisplayName:   u.DisplayName,
-		LastOrgID:     u.LastOrgID,
-		LastProjectID: u.LastProjectID,
-		CreatedAt:     u.CreatedAt,
-	})
+	return c.JSON(http.StatusOK, newUserResponse(u))
 }
 
 // UpdateMe updates the current authenticated user
@@ -294,6 +638,7 @@ func (h *AuthHandler) UpdateMe(c echo.Context) error {
 
 	var req struct {
 		DisplayName *string `json:"display_name,omitempty"`
+		Timezone    *string `json:"timezone,omitempty"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
@@ -305,19 +650,203 @@ func (h *AuthHandler) UpdateMe(c echo.Context) error {
 	if req.DisplayName != nil && *req.DisplayName != "" {
 		update.SetDisplayName(*req.DisplayName)
 	}
+	if req.Timezone != nil {
+		if !isValidTimezone(*req.Timezone) {
+			return echo.NewHTTPError(http.StatusBadRequest, "timezone must be a valid IANA time zone name")
+		}
+		update.SetTimezone(*req.Timezone)
+	}
 
 	u, err := update.Save(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user")
 	}
 
-	return c.JSON(http.StatusOK, UserResponse{
-		ID:            u.ID,
-		Email:         u.Email,
-		DisplayName:   u.DisplayName,
-		LastOrgID:     u.LastOrgID,
-		LastProjectID: u.LastProjectID,
-		CreatedAt:     u.CreatedAt,
-	})
+	return c.JSON(http.StatusOK, newUserResponse(u))
 }
 
+// deletedUserPasswordHash is stored for anonymized users; it is not a valid bcrypt hash so no password matches it
+const deletedUserPasswordHash = "!"
+
+// DeleteMeRequest represents the request to delete the current user's account
+type DeleteMeRequest struct {
+	Password string `json:"password" validate:"required"`
+}
+
+// errSoleOwner aborts an account deletion that would leave organizations without an owner
+var errSoleOwner = errors.New("user is the sole owner of organizations")
+
+// soleOwnedOrganizations returns the slugs of the organizations the user is the only owner of
+func soleOwnedOrganizations(ctx context.Context, tx *ent.Tx, userID uuid.UUID) ([]string, error) {
+	ownerships, err := tx.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.RoleEQ(organizationmember.RoleOwner),
+		).
+		WithOrganization().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slugs := []string{}
+	for _, m := range ownerships {
+		owners, err := tx.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationIDEQ(m.OrganizationID),
+				organizationmember.RoleEQ(organizationmember.RoleOwner),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if owners <= 1 {
+			slugs = append(slugs, m.Edges.Organization.Slug)
+		}
+	}
+	return slugs, nil
+}
+
+// DeleteMe anonymizes the current authenticated user (right to be forgotten).
+// Personal data is scrubbed and memberships are removed, but the user row is kept
+// so that content referencing it (e.g. sent invites) stays intact. A confirmation
+// is emailed to the address the account had.
+func (h *AuthHandler) DeleteMe(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req DeleteMeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := validate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	ctx := c.Request().Context()
+
+	u, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
+	}
+
+	// Require the current password to confirm the deletion
+	if !auth.CheckPassword(req.Password, u.PasswordHash) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid password")
+	}
+
+	// The ownership check runs in the same transaction as the deletion, so the organizations it
+	// vets are the ones the user leaves
+	var soleOwnedOrgs []string
+	var memberships []*ent.OrganizationMember
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		var err error
+		soleOwnedOrgs, err = soleOwnedOrganizations(ctx, tx, userID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memberships").SetInternal(err)
+		}
+		if len(soleOwnedOrgs) > 0 {
+			return errSoleOwner
+		}
+
+		// Remember the organizations being left for their activity feeds
+		memberships, err = tx.OrganizationMember.Query().
+			Where(organizationmember.UserIDEQ(userID)).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memberships").SetInternal(err)
+		}
+
+		// Remove memberships
+		if _, err := tx.OrganizationMember.Delete().
+			Where(organizationmember.UserIDEQ(userID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove organization memberships").SetInternal(err)
+		}
+		if _, err := tx.ProjectMember.Delete().
+			Where(projectmember.UserIDEQ(userID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove project memberships").SetInternal(err)
+		}
+		if _, err := tx.TaskWatcher.Delete().
+			Where(taskwatcher.UserIDEQ(userID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove task watchers").SetInternal(err)
+		}
+		if _, err := tx.Notification.Delete().
+			Where(notification.UserIDEQ(userID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove notifications").SetInternal(err)
+		}
+
+		// Keys act on the user's behalf, so they go with the account
+		if _, err := tx.APIKey.Delete().
+			Where(apikey.CreatedByIDEQ(userID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove api keys").SetInternal(err)
+		}
+
+		// Pending invites addressed to the user hold their email address
+		if _, err := tx.Invite.Delete().
+			Where(
+				invite.EmailEqualFold(u.Email),
+				invite.UsedAtIsNil(),
+			).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove pending invites").SetInternal(err)
+		}
+
+		// Scrub personal data, keeping the row for referential integrity
+		_, err = tx.User.UpdateOneID(userID).
+			SetEmail(fmt.Sprintf("deleted-%s@deleted.invalid", userID)).
+			SetDisplayName("Deleted user").
+			SetPasswordHash(deletedUserPasswordHash).
+			ClearLastOrgID().
+			ClearLastProjectID().
+			SetDeletedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to anonymize user").SetInternal(err)
+		}
+		return nil
+	})
+	if errors.Is(err, errSoleOwner) {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"error": APIError{
+				Code:    errorCode(http.StatusConflict),
+				Message: "transfer ownership of these organizations before deleting your account",
+			},
+			"organizations": soleOwnedOrgs,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	// The address is gone from the account, so confirm to the one captured before the scrub
+	if err := h.emailService.SendAccountDeletedEmail(ctx, requestLocale(c), u.Email, u.DisplayName); err != nil {
+		logging.FromContext(ctx).Error("failed to send account deleted email", "user_id", userID, "error", err)
+	}
+
+	logging.FromContext(ctx).Info("user anonymized at their request", "user_id", userID)
+
+	for _, m := range memberships {
+		recordActivity(ctx, h.client, activityEntry{
+			OrgID:      m.OrganizationID,
+			ActorID:    userID,
+			Action:     ActivityMemberRemoved,
+			TargetType: ActivityTargetUser,
+			TargetID:   userID,
+			Metadata:   map[string]any{"reason": "account_deleted"},
+		})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
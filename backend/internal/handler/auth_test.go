@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"backend/internal/auth"
+	"backend/internal/ratelimit"
+	"backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func TestFormatValidationError(t *testing.T) {
+	policy := auth.PasswordPolicy{MinLength: 10, RequireDigit: true, RequireMixedCase: true}
+	passwordPolicy = func() auth.PasswordPolicy { return policy }
+	t.Cleanup(func() { passwordPolicy = sync.OnceValue(auth.PasswordPolicyFromEnv) })
+
+	err := orgValidate.Struct(RegisterRequest{Email: "not-an-email", Password: "short"})
+	want := []FieldError{
+		{Field: "email", Message: "email must be a valid email address"},
+		{Field: "password", Message: "password must be at least 10 characters"},
+		{Field: "password", Message: "password must contain a digit"},
+		{Field: "password", Message: "password must contain both upper and lower case letters"},
+		{Field: "display_name", Message: "display_name is required"},
+	}
+	if got := formatValidationError(err); !reflect.DeepEqual(got, want) {
+		t.Errorf("formatValidationError() = %+v, want %+v", got, want)
+	}
+
+	wantMessage := "email must be a valid email address; password must be at least 10 characters; " +
+		"password must contain a digit; password must contain both upper and lower case letters; display_name is required"
+	status, body := publicError(validationError(err))
+	if status != http.StatusBadRequest || body.Code != "validation_failed" || body.Message != wantMessage || len(body.Fields) != len(want) {
+		t.Errorf("publicError() = %d, %+v; want %d with message %q and %d fields", status, body, http.StatusBadRequest, wantMessage, len(want))
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"user@example.com", "user@example.com"},
+		{"User@Example.com", "user@example.com"},
+		{"  user@example.com\t", "user@example.com"},
+		{" USER@EXAMPLE.COM ", "user@example.com"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeEmail(tt.in); got != tt.want {
+			t.Errorf("normalizeEmail(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+// newTestAuthHandler creates an auth handler on client with login limits out of the way
+func newTestAuthHandler(t testing.TB) (*AuthHandler, *auth.JWTService) {
+	t.Helper()
+	client := newTestClient(t)
+	jwtService, err := auth.NewJWTService(auth.NewTokenRevocations(client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := ratelimit.NewAttemptLimiter(ratelimit.NewMemoryAttemptStore(), 0, time.Minute)
+	return NewAuthHandler(client, jwtService, emailService, attempts), jwtService
+}
+
+func TestRegisterAndLoginNormalizeEmail(t *testing.T) {
+	h, _ := newTestAuthHandler(t)
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Body:   RegisterRequest{Email: "  User@Example.com ", Password: "correct horse 1", DisplayName: "User"},
+	})
+	if status := statusOf(t, h.Register(c), rec); status != http.StatusCreated {
+		t.Fatalf("register: status %d, want %d", status, http.StatusCreated)
+	}
+	var registered AuthResponse
+	decodeResponse(t, rec, &registered)
+	if registered.User.Email != "user@example.com" {
+		t.Errorf("stored email %q, want it normalized", registered.User.Email)
+	}
+
+	// Case and whitespace variants are the same account
+	for _, email := range []string{"user@example.com", "USER@EXAMPLE.COM", "\tuser@Example.COM"} {
+		c, rec := newTestContext(t, testRequest{
+			Method: http.MethodPost,
+			Body:   RegisterRequest{Email: email, Password: "correct horse 1", DisplayName: "Other"},
+		})
+		if status := statusOf(t, h.Register(c), rec); status != http.StatusConflict {
+			t.Errorf("register %q: status %d, want %d", email, status, http.StatusConflict)
+		}
+
+		c, rec = newTestContext(t, testRequest{
+			Method: http.MethodPost,
+			Body:   LoginRequest{Email: email, Password: "correct horse 1"},
+		})
+		if status := statusOf(t, h.Login(c), rec); status != http.StatusOK {
+			t.Errorf("login %q: status %d, want %d", email, status, http.StatusOK)
+		}
+	}
+}
+
+func TestRenameTakesEffectWithExistingToken(t *testing.T) {
+	h, jwtService := newTestAuthHandler(t)
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Body:   RegisterRequest{Email: "user@example.com", Password: "correct horse 1", DisplayName: "Before"},
+	})
+	if status := statusOf(t, h.Register(c), rec); status != http.StatusCreated {
+		t.Fatalf("register: status %d, want %d", status, http.StatusCreated)
+	}
+	var registered AuthResponse
+	decodeResponse(t, rec, &registered)
+
+	// The token carries no display name that could go stale
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(registered.AccessToken, ".")[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(payload), "Before") {
+		t.Errorf("access token payload %s contains the display name", payload)
+	}
+
+	e := echo.New()
+	me := e.Group("/me", auth.AuthMiddleware(jwtService))
+	me.GET("", h.GetMe)
+	me.PATCH("", h.UpdateMe)
+	do := func(method, body string) UserResponse {
+		t.Helper()
+		req := httptest.NewRequest(method, "/me", strings.NewReader(body))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+registered.AccessToken)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s /me: status %d: %s", method, rec.Code, rec.Body)
+		}
+		var u UserResponse
+		decodeResponse(t, rec, &u)
+		return u
+	}
+
+	if u := do(http.MethodPatch, `{"display_name":"After"}`); u.DisplayName != "After" {
+		t.Errorf("PATCH /me: display name %q, want %q", u.DisplayName, "After")
+	}
+	if u := do(http.MethodGet, ""); u.DisplayName != "After" {
+		t.Errorf("GET /me with the pre-rename token: display name %q, want %q", u.DisplayName, "After")
+	}
+}
+
+func TestRegisterRejectsPasswordTooLongForBcrypt(t *testing.T) {
+	h, _ := newTestAuthHandler(t)
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Body:   RegisterRequest{Email: "user@example.com", Password: strings.Repeat("a", 73), DisplayName: "User"},
+	})
+	err := h.Register(c)
+	if status := statusOf(t, err, rec); status != http.StatusBadRequest {
+		t.Fatalf("status %d, want %d", status, http.StatusBadRequest)
+	}
+	if _, body := publicError(err); !strings.Contains(body.Message, "at most 72 bytes") {
+		t.Errorf("message %q, want it to explain the limit", body.Message)
+	}
+}
+
+func TestIntrospect(t *testing.T) {
+	h, jwtService := newTestAuthHandler(t)
+	userID := uuid.New()
+
+	pair, err := jwtService.GenerateTokenPair(userID, "user@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	introspect := func(token string) map[string]any {
+		t.Helper()
+		c, rec := newTestContext(t, testRequest{Method: http.MethodPost, Body: IntrospectRequest{Token: token}})
+		if status := statusOf(t, h.Introspect(c), rec); status != http.StatusOK {
+			t.Fatalf("status %d, want %d", status, http.StatusOK)
+		}
+		var resp map[string]any
+		decodeResponse(t, rec, &resp)
+		return resp
+	}
+
+	if resp := introspect(pair.AccessToken); resp["active"] != true || resp["user_id"] != userID.String() {
+		t.Errorf("access token: %v", resp)
+	}
+	for name, token := range map[string]string{"refresh token": pair.RefreshToken, "malformed": "not.a.token"} {
+		if resp := introspect(token); resp["active"] != false || len(resp) != 1 {
+			t.Errorf("%s: %v, want only active: false", name, resp)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"net/http"
+
+	"backend/ent"
+	"backend/internal/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+// BootstrapHandler serves the data the frontend needs on app start in a single request
+type BootstrapHandler struct {
+	client *ent.Client
+}
+
+// NewBootstrapHandler creates a new bootstrap handler
+func NewBootstrapHandler(client *ent.Client) *BootstrapHandler {
+	return &BootstrapHandler{client: client}
+}
+
+// BootstrapResponse represents the initial app state for the current user
+type BootstrapResponse struct {
+	User          UserResponse           `json:"user"`
+	Organizations []OrganizationResponse `json:"organizations"`
+	Context       *ContextResponse       `json:"context"`
+	// UnreadNotifications is the number of unread notifications, for the badge
+	UnreadNotifications int `json:"unread_notifications"`
+}
+
+// GetBootstrap returns the current user, their organizations, the resolved context and the
+// unread notification count, replacing the separate /me, /organizations, /context and
+// /notifications calls on app start
+func (h *BootstrapHandler) GetBootstrap(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	u, err := loadContextUser(ctx, h.client, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find user")
+	}
+
+	orgs, err := listUserOrganizations(ctx, h.client, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list organizations")
+	}
+
+	// Resolving the context may clear a stale last org/project on the user row
+	resolved, err := resolveContext(ctx, h.client, u)
+	if err != nil {
+		return err
+	}
+
+	unread, err := countUnreadNotifications(ctx, h.client, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count unread notifications")
+	}
+
+	return c.JSON(http.StatusOK, BootstrapResponse{
+		User:                newUserResponse(u),
+		Organizations:       orgs,
+		Context:             resolved,
+		UnreadNotifications: unread,
+	})
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetBootstrap(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := NewBootstrapHandler(client)
+
+	u := createTestUser(t, client, "user@example.com")
+	other := createTestUser(t, client, "other@example.com")
+	createTestOrg(t, client, "acme", u)
+
+	// Two unread and one read notification, plus one for someone else
+	for _, n := range []struct {
+		userID uuid.UUID
+		read   bool
+	}{{u.ID, false}, {u.ID, false}, {u.ID, true}, {other.ID, false}} {
+		create := client.Notification.Create().
+			SetUserID(n.userID).
+			SetType(NotificationTaskAssigned)
+		if n.read {
+			create.SetReadAt(time.Now())
+		}
+		if err := create.Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, rec := newTestContext(t, testRequest{UserID: u.ID})
+	if status := statusOf(t, h.GetBootstrap(c), rec); status != http.StatusOK {
+		t.Fatalf("status %d, want %d: %s", status, http.StatusOK, rec.Body.String())
+	}
+	var resp BootstrapResponse
+	decodeResponse(t, rec, &resp)
+	if resp.User.ID != u.ID || len(resp.Organizations) != 1 || resp.Organizations[0].Slug != "acme" {
+		t.Errorf("user %s with organizations %+v, want %s in acme", resp.User.ID, resp.Organizations, u.ID)
+	}
+	if resp.UnreadNotifications != 2 {
+		t.Errorf("unread notifications %d, want 2", resp.UnreadNotifications)
+	}
+}
@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/ent"
+	"backend/ent/organization"
+	"backend/ent/project"
+	"backend/ent/task"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CalendarHandler serves users' task due dates as iCalendar feeds
+type CalendarHandler struct {
+	client     *ent.Client
+	jwtService *auth.JWTService
+}
+
+// NewCalendarHandler creates a new calendar handler
+func NewCalendarHandler(client *ent.Client, jwtService *auth.JWTService) *CalendarHandler {
+	return &CalendarHandler{
+		client:     client,
+		jwtService: jwtService,
+	}
+}
+
+// CalendarTokenResponse holds a calendar feed token and the feed URL that uses it
+type CalendarTokenResponse struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CreateCalendarToken issues a token for subscribing to the user's calendar feed of an
+// organization from a calendar app
+func (h *CalendarHandler) CreateCalendarToken(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, _, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	token, expiresAt, err := h.jwtService.GenerateCalendarToken(userID, org.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate calendar token")
+	}
+
+	feedURL := fmt.Sprintf("%s://%s/api/v1/organizations/%s/tasks.ics?token=%s",
+		c.Scheme(), c.Request().Host, url.PathEscape(org.Slug), url.QueryEscape(token))
+
+	return c.JSON(http.StatusCreated, CalendarTokenResponse{
+		Token:     token,
+		URL:       feedURL,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// calendarUser authenticates a feed request, either with a regular access token or with a
+// calendar token in the ?token= query parameter
+func (h *CalendarHandler) calendarUser(c echo.Context, org *ent.Organization) (uuid.UUID, error) {
+	if userID, ok := auth.GetUserID(c); ok {
+		return userID, nil
+	}
+
+	raw := c.QueryParam("token")
+	if raw == "" {
+		return uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	claims, err := h.jwtService.ValidateCalendarToken(raw)
+	if err != nil || claims.OrganizationID != org.ID {
+		return uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid calendar token")
+	}
+
+	// Like refresh tokens, calendar tokens die with a password change or account deletion
+	u, err := h.client.User.Get(c.Request().Context(), claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid calendar token")
+		}
+		return uuid.Nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+	if u.DeletedAt != nil || (u.PasswordChangedAt != nil && claims.IssuedAt.Before(u.PasswordChangedAt.Truncate(time.Second))) {
+		return uuid.Nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid calendar token")
+	}
+
+	return u.ID, nil
+}
+
+// GetCalendarFeed serves an iCalendar feed with an event for each task in the organization
+// that is assigned to the user and has a due date
+func (h *CalendarHandler) GetCalendarFeed(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	org, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(c.Param("slug"))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+	}
+
+	userID, err := h.calendarUser(c, org)
+	if err != nil {
+		return err
+	}
+
+	// The user must still belong to the organization
+	if _, _, err := loadOrgMembership(ctx, h.client, userID, org.Slug); err != nil {
+		return err
+	}
+
+	loc, err := userLocation(ctx, h.client, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+
+	tasks, err := h.client.Task.Query().
+		Where(
+			task.AssigneeIDEQ(userID),
+			task.DueDateNotNil(),
+			task.HasProjectWith(
+				project.OrganizationIDEQ(org.ID),
+				project.DeletedAtIsNil(),
+				visibleProject(userID),
+			),
+		).
+		WithProject().
+		Order(ent.Asc(task.FieldDueDate), ent.Asc(task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list tasks")
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/calendar; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s-tasks.ics"`, org.Slug))
+	res.WriteHeader(http.StatusOK)
+
+	w := bufio.NewWriter(res)
+	now := time.Now()
+	writeICalLine(w, "BEGIN:VCALENDAR")
+	writeICalLine(w, "VERSION:2.0")
+	writeICalLine(w, "PRODID:-//Team Todo//Tasks//EN")
+	writeICalLine(w, "CALSCALE:GREGORIAN")
+	writeICalLine(w, "METHOD:PUBLISH")
+	writeICalLine(w, "X-WR-CALNAME:"+icalEscape("Team Todo: "+org.Name))
+	// Times stay in UTC; calendar apps show them in the user's time zone
+	writeICalLine(w, "X-WR-TIMEZONE:"+loc.String())
+	for _, t := range tasks {
+		writeICalLine(w, "BEGIN:VEVENT")
+		writeICalLine(w, "UID:"+t.ID.String()+"@team-todo")
+		writeICalLine(w, "DTSTAMP:"+icalTime(now))
+		writeICalLine(w, "DTSTART:"+icalTime(*t.DueDate))
+		writeICalLine(w, "LAST-MODIFIED:"+icalTime(t.UpdatedAt))
+		writeICalLine(w, "SUMMARY:"+icalEscape(t.Title))
+		if t.Description != "" {
+			writeICalLine(w, "DESCRIPTION:"+icalEscape(t.Description))
+		}
+		if t.Edges.Project != nil {
+			writeICalLine(w, "CATEGORIES:"+icalEscape(t.Edges.Project.Name))
+		}
+		writeICalLine(w, "END:VEVENT")
+	}
+	writeICalLine(w, "END:VCALENDAR")
+
+	return w.Flush()
+}
+
+// icalTime formats a time as an RFC 5545 UTC date-time
+func icalTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// icalEscape escapes a TEXT value per RFC 5545 section 3.3.11
+func icalEscape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// writeICalLine writes a content line, folding it so no physical line exceeds 75 octets
+// (RFC 5545 section 3.1) without splitting a UTF-8 character
+func writeICalLine(w *bufio.Writer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with the folding space
+		limit = 74
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/internal/auth"
+	"backend/internal/logging"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CreateCommentRequest represents the request to comment on a task
+type CreateCommentRequest struct {
+	Body string `json:"body" validate:"required,max=10000"`
+}
+
+// CommentResponse represents a comment on a task
+type CommentResponse struct {
+	ID        uuid.UUID        `json:"id"`
+	TaskID    uuid.UUID        `json:"task_id"`
+	Body      string           `json:"body"`
+	Author    TaskUserResponse `json:"author"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+func newCommentResponse(cm *ent.Comment, author *ent.User) CommentResponse {
+	return CommentResponse{
+		ID:     cm.ID,
+		TaskID: cm.TaskID,
+		Body:   cm.Body,
+		Author: TaskUserResponse{
+			ID:          author.ID,
+			DisplayName: author.DisplayName,
+			Email:       author.Email,
+		},
+		CreatedAt: cm.CreatedAt,
+		UpdatedAt: cm.UpdatedAt,
+	}
+}
+
+// CreateComment adds a comment to a task. Anyone who can view the project may comment.
+func (h *TaskHandler) CreateComment(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req CreateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.Body = strings.TrimSpace(req.Body)
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := requireWriteAccess(access.Membership); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	cm, err := h.client.Comment.Create().
+		SetTaskID(t.ID).
+		SetAuthorID(userID).
+		SetBody(req.Body).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create comment")
+	}
+
+	author, err := h.client.User.Get(ctx, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+
+	h.notifyWatchers(ctx, requestLocale(c), access, t, taskChange{ActorID: userID, Comment: cm.Body})
+	if err := h.notifyMentions(ctx, access, t, cm); err != nil {
+		logging.FromContext(ctx).Error("failed to notify mentioned members", "comment_id", cm.ID, "error", err)
+	}
+
+	return c.JSON(http.StatusCreated, newCommentResponse(cm, author))
+}
+
+// notifyMentions notifies the members of the organization a comment mentions, as @display_name
+// or @email, who can view the task's project
+func (h *TaskHandler) notifyMentions(ctx context.Context, access *projectAccess, t *ent.Task, cm *ent.Comment) error {
+	if !strings.Contains(cm.Body, "@") {
+		return nil
+	}
+
+	members, err := h.client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(access.Org.ID)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	var mentioned []*ent.OrganizationMember
+	for _, m := range members {
+		if m.UserID != cm.AuthorID && m.Edges.User != nil && mentionsUser(cm.Body, m.Edges.User) {
+			mentioned = append(mentioned, m)
+		}
+	}
+	if len(mentioned) == 0 {
+		return nil
+	}
+
+	userIDs := make([]uuid.UUID, len(mentioned))
+	for i, m := range mentioned {
+		userIDs[i] = m.UserID
+	}
+	projectMemberships, err := h.client.ProjectMember.Query().
+		Where(
+			projectmember.ProjectIDEQ(access.Project.ID),
+			projectmember.UserIDIn(userIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	explicit := make(map[uuid.UUID]*projectmember.Permission, len(projectMemberships))
+	for _, pm := range projectMemberships {
+		explicit[pm.UserID] = &pm.Permission
+	}
+
+	for _, m := range mentioned {
+		if _, ok := projectPermission(access.Org, m, access.Project, explicit[m.UserID]); !ok {
+			continue
+		}
+		payload := taskActivityMetadata(access.Project, t)
+		payload["task_id"] = t.ID
+		payload["comment_id"] = cm.ID
+		payload["organization_slug"] = access.Org.Slug
+		notify(ctx, h.client, notificationEntry{
+			UserID:  m.UserID,
+			ActorID: cm.AuthorID,
+			Type:    NotificationMentioned,
+			Payload: payload,
+		})
+	}
+	return nil
+}
+
+// mentionsUser reports whether body mentions u as @email or @display_name, ignoring case. The
+// mention must not run on into a longer word, so that @Ann doesn't mention Anna.
+func mentionsUser(body string, u *ent.User) bool {
+	body = strings.ToLower(body)
+	for _, name := range []string{u.Email, u.DisplayName} {
+		if name == "" {
+			continue
+		}
+		mention := "@" + strings.ToLower(name)
+		for rest := body; ; {
+			i := strings.Index(rest, mention)
+			if i < 0 {
+				break
+			}
+			rest = rest[i+len(mention):]
+			if r, _ := utf8.DecodeRuneInString(rest); rest == "" || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '@') {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ListComments lists the comments on a task, oldest first
+func (h *TaskHandler) ListComments(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	comments, err := h.client.Comment.Query().
+		Where(comment.TaskIDEQ(t.ID)).
+		WithAuthor().
+		Order(ent.Asc(comment.FieldCreatedAt, comment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get comments")
+	}
+
+	result := make([]CommentResponse, len(comments))
+	for i, cm := range comments {
+		result[i] = newCommentResponse(cm, cm.Edges.Author)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// DeleteComment deletes a comment (author or organization admin only)
+func (h *TaskHandler) DeleteComment(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	commentID, err := uuid.Parse(c.Param("comment_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid comment_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := requireWriteAccess(access.Membership); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	cm, err := h.client.Comment.Query().
+		Where(
+			comment.IDEQ(commentID),
+			comment.TaskIDEQ(t.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "comment not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get comment")
+	}
+
+	if cm.AuthorID != userID && !HasAdminPermission(access.Membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only the author or an admin can delete this comment")
+	}
+
+	if err := h.client.Comment.DeleteOneID(cm.ID).Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete comment")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
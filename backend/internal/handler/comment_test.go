@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/notification"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/internal/service"
+)
+
+func TestMentionsUser(t *testing.T) {
+	u := &ent.User{Email: "ann@example.com", DisplayName: "Ann Lee"}
+
+	tests := []struct {
+		body string
+		want bool
+	}{
+		{"@Ann Lee please look", true},
+		{"thanks @ann lee!", true},
+		{"cc @ann@example.com", true},
+		{"(@ANN@EXAMPLE.COM)", true},
+		{"Ann Lee, please look", false},
+		{"@Ann Leewood please look", false},
+		{"@ann lee_2 please look", false},
+		{"@ann@example.company", false},
+		{"@Ann Le", false},
+		{"@Anna Lee and @Ann Lee", true},
+	}
+	for _, tt := range tests {
+		if got := mentionsUser(tt.body, u); got != tt.want {
+			t.Errorf("mentionsUser(%q) = %v, want %v", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestCreateCommentNotifiesMentions(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewTaskHandler(client, emailService, nil)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	outsider := createTestUser(t, client, "outsider@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	addTestMember(t, client, org, member, organizationmember.RoleMember)
+	addTestMember(t, client, org, outsider, organizationmember.RoleMember)
+	if err := member.Update().SetDisplayName("Mei Chen").Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	proj := createTestProject(t, client, org, "Project", true)
+	if err := client.ProjectMember.Create().
+		SetProjectID(proj.ID).
+		SetUserID(member.ID).
+		SetPermission(projectmember.PermissionView).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	task, err := client.Task.Create().
+		SetProjectID(proj.ID).
+		SetTitle("Task").
+		SetCreatedByID(owner.ID).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: map[string]string{"slug": org.Slug, "project_id": proj.ID.String(), "task_id": task.ID.String()},
+		Body:   CreateCommentRequest{Body: "@mei chen and @outsider@example.com, what do you think? /@owner@example.com"},
+		UserID: owner.ID,
+	})
+	if status := statusOf(t, h.CreateComment(c), rec); status != http.StatusCreated {
+		t.Fatalf("status %d, want %d: %s", status, http.StatusCreated, rec.Body.String())
+	}
+	var cm CommentResponse
+	decodeResponse(t, rec, &cm)
+
+	// Notifications are created in the background
+	var n *ent.Notification
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		n, err = client.Notification.Query().Where(notification.UserIDEQ(member.ID)).Only(ctx)
+		if err == nil || !ent.IsNotFound(err) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("mentioned member's notification: %v", err)
+	}
+	if n.Type != NotificationMentioned || n.Payload["comment_id"] != cm.ID.String() || n.Payload["task_id"] != task.ID.String() {
+		t.Errorf("notification %s with payload %v, want %s for comment %s", n.Type, n.Payload, NotificationMentioned, cm.ID)
+	}
+
+	// The outsider can't view the private project and the author isn't notified of their own mention
+	for _, u := range []*ent.User{outsider, owner} {
+		count, err := client.Notification.Query().Where(notification.UserIDEQ(u.ID)).Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("%s has %d notifications, want none", u.Email, count)
+		}
+	}
+}
@@ -1,26 +1,33 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"slices"
+	"time"
 
 	"backend/ent"
+	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/user"
 	"backend/internal/auth"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 // ContextHandler handles context-related requests (for restoring user's last state)
 type ContextHandler struct {
-	client *ent.Client
+	client     *ent.Client
+	jwtService *auth.JWTService
 }
 
 // NewContextHandler creates a new context handler
-func NewContextHandler(client *ent.Client) *ContextHandler {
-	return &ContextHandler{client: client}
+func NewContextHandler(client *ent.Client, jwtService *auth.JWTService) *ContextHandler {
+	return &ContextHandler{client: client, jwtService: jwtService}
 }
 
 // ContextResponse represents the user's current context
@@ -41,7 +48,7 @@ func (h *ContextHandler) GetCurrentContext(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	// Get user with last context
-	user, err := h.client.User.Get(ctx, userID)
+	u, err := loadContextUser(ctx, h.client, userID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return echo.NewHTTPError(http.StatusNotFound, "user not found")
@@ -49,19 +56,171 @@ func (h *ContextHandler) GetCurrentContext(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
 	}
 
+	response, err := resolveContext(ctx, h.client, u)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// contextRow is the result of the context query: the user's columns followed by their last
+// organization and project, each with the user's membership in it. The joined columns are NULL
+// when there is no such organization, project or membership.
+type contextRow struct {
+	ent.User
+	OrgID                       *uuid.UUID `sql:"org_id"`
+	OrgName                     *string    `sql:"org_name"`
+	OrgSlug                     *string    `sql:"org_slug"`
+	OrgDefaultProjectPermission *string    `sql:"org_default_project_permission"`
+	OrgCreatedAt                *time.Time `sql:"org_created_at"`
+	MemberID                    *int       `sql:"member_id"`
+	MemberRole                  *string    `sql:"member_role"`
+	MemberReadOnly              *bool      `sql:"member_read_only"`
+	ProjectID                   *uuid.UUID `sql:"project_id"`
+	ProjectOrgID                *uuid.UUID `sql:"project_org_id"`
+	ProjectName                 *string    `sql:"project_name"`
+	ProjectIsPrivate            *bool      `sql:"project_is_private"`
+	ProjectIsDefault            *bool      `sql:"project_is_default"`
+	ProjectCreatedByID          *uuid.UUID `sql:"project_created_by_id"`
+	ProjectCreatedAt            *time.Time `sql:"project_created_at"`
+	ProjectUpdatedAt            *time.Time `sql:"project_updated_at"`
+	CreatorName                 *string    `sql:"creator_name"`
+	ProjectPermission           *string    `sql:"project_permission"`
+}
+
+// loadContextUser loads a user together with everything resolveContext reads, in one query:
+// the last organization with the user's membership in it, and the last project with its
+// creator and the user's project membership. The edges are filled in on the returned user.
+// Deleted projects are left out, as the soft-delete interceptor would.
+func loadContextUser(ctx context.Context, client *ent.Client, userID uuid.UUID) (*ent.User, error) {
+	// The password hash is not needed and has no field to scan into
+	columns := slices.DeleteFunc(slices.Clone(user.Columns), func(c string) bool {
+		return c == user.FieldPasswordHash
+	})
+
+	var rows []contextRow
+	err := client.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(columns...).
+		Modify(func(s *sql.Selector) {
+			orgs := sql.Table(organization.Table).As("o")
+			members := sql.Table(organizationmember.Table).As("om")
+			projects := sql.Table(project.Table).As("p")
+			creators := sql.Table(user.Table).As("c")
+			projectMembers := sql.Table(projectmember.Table).As("pm")
+			s.LeftJoin(orgs).
+				On(s.C(user.FieldLastOrgID), orgs.C(organization.FieldID)).
+				LeftJoin(members).
+				OnP(sql.And(
+					sql.ColumnsEQ(members.C(organizationmember.FieldOrganizationID), orgs.C(organization.FieldID)),
+					sql.ColumnsEQ(members.C(organizationmember.FieldUserID), s.C(user.FieldID)),
+				)).
+				LeftJoin(projects).
+				OnP(sql.And(
+					sql.ColumnsEQ(projects.C(project.FieldID), s.C(user.FieldLastProjectID)),
+					sql.IsNull(projects.C(project.FieldDeletedAt)),
+				)).
+				LeftJoin(creators).
+				On(projects.C(project.FieldCreatedByID), creators.C(user.FieldID)).
+				LeftJoin(projectMembers).
+				OnP(sql.And(
+					sql.ColumnsEQ(projectMembers.C(projectmember.FieldProjectID), projects.C(project.FieldID)),
+					sql.ColumnsEQ(projectMembers.C(projectmember.FieldUserID), s.C(user.FieldID)),
+				))
+			s.AppendSelectAs(orgs.C(organization.FieldID), "org_id").
+				AppendSelectAs(orgs.C(organization.FieldName), "org_name").
+				AppendSelectAs(orgs.C(organization.FieldSlug), "org_slug").
+				AppendSelectAs(orgs.C(organization.FieldDefaultProjectPermission), "org_default_project_permission").
+				AppendSelectAs(orgs.C(organization.FieldCreatedAt), "org_created_at").
+				AppendSelectAs(members.C(organizationmember.FieldID), "member_id").
+				AppendSelectAs(members.C(organizationmember.FieldRole), "member_role").
+				AppendSelectAs(members.C(organizationmember.FieldReadOnly), "member_read_only").
+				AppendSelectAs(projects.C(project.FieldID), "project_id").
+				AppendSelectAs(projects.C(project.FieldOrganizationID), "project_org_id").
+				AppendSelectAs(projects.C(project.FieldName), "project_name").
+				AppendSelectAs(projects.C(project.FieldIsPrivate), "project_is_private").
+				AppendSelectAs(projects.C(project.FieldIsDefault), "project_is_default").
+				AppendSelectAs(projects.C(project.FieldCreatedByID), "project_created_by_id").
+				AppendSelectAs(projects.C(project.FieldCreatedAt), "project_created_at").
+				AppendSelectAs(projects.C(project.FieldUpdatedAt), "project_updated_at").
+				AppendSelectAs(creators.C(user.FieldDisplayName), "creator_name").
+				AppendSelectAs(projectMembers.C(projectmember.FieldPermission), "project_permission")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+	row := rows[0]
+
+	u := row.User
+	if row.OrgID != nil {
+		org := &ent.Organization{
+			ID:                       *row.OrgID,
+			Name:                     *row.OrgName,
+			Slug:                     *row.OrgSlug,
+			DefaultProjectPermission: organization.DefaultProjectPermission(*row.OrgDefaultProjectPermission),
+			CreatedAt:                *row.OrgCreatedAt,
+		}
+		if row.MemberID != nil {
+			org.Edges.OrganizationMemberships = []*ent.OrganizationMember{{
+				ID:             *row.MemberID,
+				UserID:         u.ID,
+				OrganizationID: org.ID,
+				Role:           organizationmember.Role(*row.MemberRole),
+				ReadOnly:       *row.MemberReadOnly,
+			}}
+		}
+		u.Edges.LastOrganization = org
+	}
+	if row.ProjectID != nil {
+		proj := &ent.Project{
+			ID:             *row.ProjectID,
+			OrganizationID: *row.ProjectOrgID,
+			Name:           *row.ProjectName,
+			IsPrivate:      *row.ProjectIsPrivate,
+			IsDefault:      *row.ProjectIsDefault,
+			CreatedByID:    row.ProjectCreatedByID,
+			CreatedAt:      *row.ProjectCreatedAt,
+			UpdatedAt:      *row.ProjectUpdatedAt,
+		}
+		if row.CreatorName != nil {
+			proj.Edges.CreatedBy = &ent.User{ID: *row.ProjectCreatedByID, DisplayName: *row.CreatorName}
+		}
+		if row.ProjectPermission != nil {
+			proj.Edges.ProjectMemberships = []*ent.ProjectMember{{
+				ProjectID:  proj.ID,
+				UserID:     u.ID,
+				Permission: projectmember.Permission(*row.ProjectPermission),
+			}}
+		}
+		u.Edges.LastProject = proj
+	}
+	return &u, nil
+}
+
+// resolveContext determines where the user should land based on their last accessed
+// organization and project, clearing them when they are no longer accessible.
+// The user must have been loaded with loadContextUser.
+func resolveContext(ctx context.Context, client *ent.Client, u *ent.User) (*ContextResponse, error) {
+	userID := u.ID
+
 	response := ContextResponse{
 		HasContext: false,
 	}
 
 	// Check if user has last org
-	if user.LastOrgID == nil {
+	if u.LastOrgID == nil {
 		// No context, check if user has any orgs
-		memberships, err := h.client.OrganizationMember.Query().
+		memberships, err := client.OrganizationMember.Query().
 			Where(organizationmember.UserIDEQ(userID)).
 			WithOrganization().
 			All(ctx)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memberships")
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get memberships")
 		}
 
 		if len(memberships) == 0 {
@@ -72,43 +231,21 @@ func (h *ContextHandler) GetCurrentContext(c echo.Context) error {
 			org := memberships[0].Edges.Organization
 			response.RedirectURL = "/org/" + org.Slug
 		}
-		return c.JSON(http.StatusOK, response)
+		return &response, nil
 	}
 
 	// Verify user still has access to the last org
-	org, err := h.client.Organization.Get(ctx, *user.LastOrgID)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			// Org no longer exists, clear context and redirect
-			_, _ = h.client.User.UpdateOneID(userID).
-				ClearLastOrgID().
-				ClearLastProjectID().
-				Save(ctx)
-			response.RedirectURL = "/org/new"
-			return c.JSON(http.StatusOK, response)
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
-	}
-
-	// Check membership
-	membership, err := h.client.OrganizationMember.Query().
-		Where(
-			organizationmember.UserIDEQ(userID),
-			organizationmember.OrganizationIDEQ(org.ID),
-		).
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			// No longer a member, clear context
-			_, _ = h.client.User.UpdateOneID(userID).
-				ClearLastOrgID().
-				ClearLastProjectID().
-				Save(ctx)
-			response.RedirectURL = "/org/new"
-			return c.JSON(http.StatusOK, response)
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	org := u.Edges.LastOrganization
+	if org == nil || len(org.Edges.OrganizationMemberships) == 0 {
+		// Org no longer exists or the user is no longer a member, clear context and redirect
+		_, _ = client.User.UpdateOneID(userID).
+			ClearLastOrgID().
+			ClearLastProjectID().
+			Save(ctx)
+		response.RedirectURL = "/org/new"
+		return &response, nil
 	}
+	membership := org.Edges.OrganizationMemberships[0]
 
 	response.HasContext = true
 	response.Organization = &OrganizationResponse{
@@ -116,62 +253,26 @@ func (h *ContextHandler) GetCurrentContext(c echo.Context) error {
 		Name:      org.Name,
 		Slug:      org.Slug,
 		Role:      string(membership.Role),
+		ReadOnly:  membership.ReadOnly,
 		CreatedAt: org.CreatedAt,
 	}
 	response.RedirectURL = "/org/" + org.Slug
 
 	// Check last project if exists
-	if user.LastProjectID != nil {
-		proj, err := h.client.Project.Query().
-			Where(
-				project.IDEQ(*user.LastProjectID),
-				project.OrganizationIDEQ(org.ID),
-			).
-			Only(ctx)
-		if err == nil {
-			// Check project access
-			hasAccess := !proj.IsPrivate // Public projects are accessible to all org members
-			permission := "view"
-
-			if proj.IsPrivate {
-				pm, err := h.client.ProjectMember.Query().
-					Where(
-						projectmember.UserIDEQ(userID),
-						projectmember.ProjectIDEQ(proj.ID),
-					).
-					Only(ctx)
-				if err == nil {
-					hasAccess = true
-					permission = string(pm.Permission)
-				}
-			} else {
-				// Check for explicit membership for higher permission
-				pm, err := h.client.ProjectMember.Query().
-					Where(
-						projectmember.UserIDEQ(userID),
-						projectmember.ProjectIDEQ(proj.ID),
-					).
-					Only(ctx)
-				if err == nil {
-					permission = string(pm.Permission)
-				}
-			}
+	if proj := u.Edges.LastProject; proj != nil && proj.OrganizationID == org.ID {
+		var explicit *projectmember.Permission
+		if len(proj.Edges.ProjectMemberships) > 0 {
+			explicit = &proj.Edges.ProjectMemberships[0].Permission
+		}
 
-			if hasAccess {
-				response.Project = &ProjectResponse{
-					ID:             proj.ID,
-					Name:           proj.Name,
-					IsPrivate:      proj.IsPrivate,
-					OrganizationID: proj.OrganizationID,
-					Permission:     permission,
-					CreatedAt:      proj.CreatedAt,
-				}
-				response.RedirectURL = "/org/" + org.Slug + "/projects/" + proj.ID.String()
-			}
+		if permission, hasAccess := projectPermission(org, membership, proj, explicit); hasAccess {
+			projectResponse := newProjectResponse(proj, string(permission))
+			response.Project = &projectResponse
+			response.RedirectURL = "/org/" + org.Slug + "/projects/" + proj.ID.String()
 		}
 	}
 
-	return c.JSON(http.StatusOK, response)
+	return &response, nil
 }
 
 // UpdateContextRequest represents the request to update context
@@ -180,7 +281,15 @@ type UpdateContextRequest struct {
 	ProjectID *string `json:"project_id,omitempty"`
 }
 
-// UpdateContext updates the user's last accessed organization and project
+// UpdateContextResponse carries an access token for the updated context
+type UpdateContextResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"` // Access token expiry in seconds
+}
+
+// UpdateContext updates the user's last accessed organization and project. It answers with a
+// new access token, whose organization claim names the organization switched to, so that
+// clients don't keep sending a token that claims the previous one.
 func (h *ContextHandler) UpdateContext(c echo.Context) error {
 	userID, ok := auth.GetUserID(c)
 	if !ok {
@@ -234,44 +343,45 @@ func (h *ContextHandler) UpdateContext(c echo.Context) error {
 		}
 
 		// Check org membership
-		exists, err := h.client.OrganizationMember.Query().
+		membership, err := h.client.OrganizationMember.Query().
 			Where(
 				organizationmember.UserIDEQ(userID),
 				organizationmember.OrganizationIDEQ(proj.OrganizationID),
 			).
-			Exist(ctx)
+			WithOrganization().
+			Only(ctx)
 		if err != nil {
+			if ent.IsNotFound(err) {
+				return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
+			}
 			return echo.NewHTTPError(http.StatusInternalServerError, "failed to verify membership")
 		}
-		if !exists {
-			return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
-		}
 
-		// Check project access for private projects
-		if proj.IsPrivate {
-			exists, err := h.client.ProjectMember.Query().
-				Where(
-					projectmember.UserIDEQ(userID),
-					projectmember.ProjectIDEQ(projectID),
-				).
-				Exist(ctx)
-			if err != nil {
-				return echo.NewHTTPError(http.StatusInternalServerError, "failed to verify project access")
-			}
-			if !exists {
-				return echo.NewHTTPError(http.StatusForbidden, "you do not have access to this project")
-			}
+		_, hasAccess, err := EffectiveProjectPermission(ctx, h.client, membership.Edges.Organization, membership, proj)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to verify project access")
+		}
+		if !hasAccess {
+			return errNoProjectAccess()
 		}
 
 		update.SetLastProjectID(projectID)
 		update.SetLastOrgID(proj.OrganizationID)
 	}
 
-	_, err := update.Save(ctx)
+	u, err := update.Save(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update context")
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	accessToken, err := h.jwtService.GenerateAccessToken(u.ID, u.Email, tokenOrganization(ctx, h.client, h.jwtService, u))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate access token").SetInternal(err)
+	}
+
+	return c.JSON(http.StatusOK, UpdateContextResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   int64(h.jwtService.AccessTokenExpiry().Seconds()),
+	})
 }
 
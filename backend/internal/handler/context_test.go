@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/migrate"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/internal/auth"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// countingDriver counts the statements run through it outside transactions
+type countingDriver struct {
+	dialect.Driver
+	queries atomic.Int64
+}
+
+func (d *countingDriver) Query(ctx context.Context, query string, args, v any) error {
+	d.queries.Add(1)
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *countingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	d.queries.Add(1)
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// newCountingTestClient is newTestClient with a driver that counts queries
+func newCountingTestClient(t testing.TB) (*ent.Client, *countingDriver) {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "test.db")+"?_fk=1&_busy_timeout=5000&_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	counting := &countingDriver{Driver: drv}
+	client := ent.NewClient(ent.Driver(counting))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(false)); err != nil {
+		t.Fatal(err)
+	}
+	return client, counting
+}
+
+// getContext calls GetCurrentContext as u
+func getContext(t testing.TB, h *ContextHandler, u *ent.User) ContextResponse {
+	t.Helper()
+	c, rec := newTestContext(t, testRequest{UserID: u.ID})
+	if err := h.GetCurrentContext(c); err != nil {
+		t.Fatal(err)
+	}
+	var resp ContextResponse
+	decodeResponse(t, rec, &resp)
+	return resp
+}
+
+// setLastContext sets the user's last organization and project
+func setLastContext(t testing.TB, client *ent.Client, u *ent.User, org *ent.Organization, proj *ent.Project) {
+	t.Helper()
+	if err := client.User.UpdateOne(u).
+		SetLastOrgID(org.ID).
+		SetLastProjectID(proj.ID).
+		Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetCurrentContext(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := NewContextHandler(client, nil)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	addTestMember(t, client, org, member, organizationmember.RoleMember)
+	orgPath := "/org/acme"
+
+	public, err := client.Project.Create().
+		SetOrganizationID(org.ID).
+		SetName("Public").
+		SetCreatedByID(owner.ID).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setLastContext(t, client, member, org, public)
+	resp := getContext(t, h, member)
+	if !resp.HasContext || resp.Organization == nil || resp.Organization.Role != "member" {
+		t.Fatalf("public project: organization %+v", resp.Organization)
+	}
+	if resp.Project == nil || resp.Project.Permission != "view" || resp.Project.CreatedBy == nil || *resp.Project.CreatedBy != owner.DisplayName {
+		t.Fatalf("public project: project %+v", resp.Project)
+	}
+	if want := orgPath + "/projects/" + public.ID.String(); resp.RedirectURL != want {
+		t.Errorf("public project: redirect %q, want %q", resp.RedirectURL, want)
+	}
+
+	private := createTestProject(t, client, org, "Private", true)
+	setLastContext(t, client, member, org, private)
+	if resp := getContext(t, h, member); resp.Project != nil || resp.RedirectURL != orgPath {
+		t.Errorf("private project of another team: project %+v, redirect %q", resp.Project, resp.RedirectURL)
+	}
+
+	if err := client.ProjectMember.Create().
+		SetProjectID(private.ID).
+		SetUserID(member.ID).
+		SetPermission(projectmember.PermissionEdit).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if resp := getContext(t, h, member); resp.Project == nil || resp.Project.Permission != "edit" {
+		t.Errorf("private project member: project %+v", resp.Project)
+	}
+
+	if err := client.Project.UpdateOne(private).SetDeletedAt(time.Now()).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if resp := getContext(t, h, member); resp.Project != nil || resp.RedirectURL != orgPath {
+		t.Errorf("deleted project: project %+v, redirect %q", resp.Project, resp.RedirectURL)
+	}
+
+	// Removed from the organization: the stale context is cleared
+	if _, err := client.OrganizationMember.Delete().
+		Where(organizationmember.UserIDEQ(member.ID)).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if resp := getContext(t, h, member); resp.HasContext || resp.RedirectURL != "/org/new" {
+		t.Errorf("removed member: %+v", resp)
+	}
+	if u := client.User.GetX(ctx, member.ID); u.LastOrgID != nil || u.LastProjectID != nil {
+		t.Errorf("removed member: context not cleared")
+	}
+
+	c, rec := newTestContext(t, testRequest{UserID: uuid.New()})
+	if status := statusOf(t, h.GetCurrentContext(c), rec); status != 404 {
+		t.Errorf("unknown user: status %d, want 404", status)
+	}
+}
+
+func TestGetCurrentContextRunsOneQuery(t *testing.T) {
+	client, counting := newCountingTestClient(t)
+	h := NewContextHandler(client, nil)
+	owner := createTestUser(t, client, "owner@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	setLastContext(t, client, owner, org, createTestProject(t, client, org, "Project", false))
+
+	counting.queries.Store(0)
+	if resp := getContext(t, h, owner); resp.Project == nil {
+		t.Fatalf("context not resolved: %+v", resp)
+	}
+	if n := counting.queries.Load(); n != 1 {
+		t.Errorf("%d queries, want 1", n)
+	}
+}
+
+func BenchmarkGetCurrentContext(b *testing.B) {
+	client, counting := newCountingTestClient(b)
+	h := NewContextHandler(client, nil)
+	owner := createTestUser(b, client, "owner@example.com")
+	org := createTestOrg(b, client, "acme", owner)
+	setLastContext(b, client, owner, org, createTestProject(b, client, org, "Project", false))
+
+	counting.queries.Store(0)
+	b.ResetTimer()
+	for range b.N {
+		getContext(b, h, owner)
+	}
+	b.ReportMetric(float64(counting.queries.Load())/float64(b.N), "queries/op")
+}
+
+func TestUpdateContextIssuesTokenForNewOrganization(t *testing.T) {
+	t.Setenv("JWT_ORG_CLAIMS", "true")
+	client := newTestClient(t)
+	jwtService, err := auth.NewJWTService(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewContextHandler(client, jwtService)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	first := createTestOrg(t, client, "first", owner)
+	second := createTestOrg(t, client, "second", owner)
+	addTestMember(t, client, first, member, organizationmember.RoleMember)
+	addTestMember(t, client, second, member, organizationmember.RoleAdmin)
+
+	for _, org := range []*ent.Organization{first, second} {
+		orgID := org.ID.String()
+		c, rec := newTestContext(t, testRequest{
+			Method: http.MethodPut,
+			Body:   UpdateContextRequest{OrgID: &orgID},
+			UserID: member.ID,
+		})
+		if status := statusOf(t, h.UpdateContext(c), rec); status != http.StatusOK {
+			t.Fatalf("switch to %s: status %d, want %d", org.Slug, status, http.StatusOK)
+		}
+		var resp UpdateContextResponse
+		decodeResponse(t, rec, &resp)
+		claims, err := jwtService.ValidateAccessToken(resp.AccessToken)
+		if err != nil {
+			t.Fatalf("switch to %s: %v", org.Slug, err)
+		}
+		if claims.UserID != member.ID || claims.Organization == nil || claims.Organization.Slug != org.Slug {
+			t.Errorf("switch to %s: token claims organization %+v", org.Slug, claims.Organization)
+		}
+		if resp.ExpiresIn <= 0 {
+			t.Errorf("switch to %s: expires_in %d", org.Slug, resp.ExpiresIn)
+		}
+	}
+}
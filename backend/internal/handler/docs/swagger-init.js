@@ -0,0 +1,6 @@
+window.onload = () => {
+  window.ui = SwaggerUIBundle({
+    url: "/api/v1/openapi.json",
+    dom_id: "#swagger-ui",
+  });
+};
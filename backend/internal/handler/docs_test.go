@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSwaggerUILoadsOnlySameOriginAssets(t *testing.T) {
+	c, rec := newTestContext(t, testRequest{Path: "docs"})
+	if err := SwaggerUI(c); err != nil {
+		t.Fatal(err)
+	}
+	if policy := rec.Header().Get("Content-Security-Policy"); strings.Contains(policy, "http") {
+		t.Errorf("policy allows another origin: %s", policy)
+	}
+	if page := rec.Body.String(); strings.Contains(page, "http") {
+		t.Errorf("page references another origin: %s", page)
+	}
+
+	for _, name := range []string{"swagger-ui.css", "swagger-ui-bundle.js"} {
+		c, rec := newTestContext(t, testRequest{Path: "docs/" + name})
+		if status := statusOf(t, SwaggerUIAsset(name)(c), rec); status != http.StatusOK || rec.Body.Len() == 0 {
+			t.Errorf("%s: status %d with %d bytes", name, status, rec.Body.Len())
+		}
+	}
+}
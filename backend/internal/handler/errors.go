@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/ent"
+	"backend/internal/logging"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// APIError is the body of every error response. Fields lists every failed field of a request
+// that failed validation, whose messages Message joins.
+type APIError struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"fields,omitempty"`
+}
+
+// FieldError describes why one request field failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse wraps an APIError as {"error": {...}}
+type ErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// errorCode derives a machine-readable code from a status, e.g. 404 becomes "not_found"
+func errorCode(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return "error"
+	}
+	return strings.ToLower(strings.ReplaceAll(text, " ", "_"))
+}
+
+// validationError reports a request that failed validation as a 400 with a user-facing message
+func validationError(err error) error {
+	return echo.NewHTTPError(http.StatusBadRequest, validationMessage(formatValidationError(err))).SetInternal(err)
+}
+
+// publicError maps err to the status and body the client sees. Messages of 4xx errors are
+// written for users and passed through; anything else gets a generic message so that
+// database errors and other internals never reach the client.
+func publicError(err error) (int, APIError) {
+	var he *echo.HTTPError
+	var validationErrors validator.ValidationErrors
+	switch {
+	case errors.As(err, &he):
+		if errors.As(he.Internal, &validationErrors) {
+			return he.Code, APIError{
+				Code:    "validation_failed",
+				Message: fmt.Sprint(he.Message),
+				Fields:  formatValidationError(validationErrors),
+			}
+		}
+		if he.Code < http.StatusInternalServerError {
+			return he.Code, APIError{Code: errorCode(he.Code), Message: fmt.Sprint(he.Message)}
+		}
+		return he.Code, APIError{Code: errorCode(he.Code), Message: strings.ToLower(http.StatusText(he.Code))}
+	case errors.As(err, &validationErrors):
+		fields := formatValidationError(validationErrors)
+		return http.StatusBadRequest, APIError{
+			Code:    "validation_failed",
+			Message: validationMessage(fields),
+			Fields:  fields,
+		}
+	case ent.IsValidationError(err):
+		return http.StatusBadRequest, APIError{Code: "validation_failed", Message: "validation failed"}
+	case ent.IsNotFound(err):
+		return http.StatusNotFound, APIError{Code: errorCode(http.StatusNotFound), Message: "not found"}
+	case ent.IsConstraintError(err):
+		return http.StatusConflict, APIError{Code: errorCode(http.StatusConflict), Message: "conflicts with existing data"}
+	default:
+		return http.StatusInternalServerError, APIError{
+			Code:    errorCode(http.StatusInternalServerError),
+			Message: strings.ToLower(http.StatusText(http.StatusInternalServerError)),
+		}
+	}
+}
+
+// HTTPErrorHandler writes errors returned by handlers and middleware as an ErrorResponse.
+// Server errors are logged with the underlying cause and the request id, which the client
+// can quote from the X-Request-ID header.
+func HTTPErrorHandler(err error, c echo.Context) {
+	status, apiErr := publicError(err)
+	if status >= http.StatusInternalServerError {
+		attrs := []any{"status", status, "error", err}
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			attrs = []any{"status", status, "error", fmt.Sprint(he.Message)}
+			if he.Internal != nil {
+				attrs = append(attrs, "cause", he.Internal)
+			}
+		}
+		logging.FromContext(c.Request().Context()).Error("request failed", attrs...)
+	}
+
+	if c.Response().Committed {
+		return
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = c.JSON(status, ErrorResponse{Error: apiErr})
+	}
+	if err != nil {
+		logging.FromContext(c.Request().Context()).Error("failed to write error response", "error", err)
+	}
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"backend/ent"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Feature flag names that can be toggled per organization. Only flags that gate an actual
+// feature belong here; requireFeature is how a handler checks one.
+const (
+	FeatureWebhooks = "webhooks"
+)
+
+// knownFeatures maps each feature flag to its value when an organization hasn't set it
+var knownFeatures = map[string]bool{
+	FeatureWebhooks: false,
+}
+
+// resolveFeatureFlags returns every known feature flag for the organization, applying defaults
+func resolveFeatureFlags(org *ent.Organization) map[string]bool {
+	flags := make(map[string]bool, len(knownFeatures))
+	for name, def := range knownFeatures {
+		flags[name] = def
+		if v, ok := org.FeatureFlags[name]; ok {
+			flags[name] = v
+		}
+	}
+	return flags
+}
+
+// FeatureEnabled checks if a feature is enabled for the organization
+func FeatureEnabled(org *ent.Organization, name string) bool {
+	return resolveFeatureFlags(org)[name]
+}
+
+// requireFeature returns a 404 error when the feature is disabled for the organization,
+// so that handlers behind the flag behave as if the endpoint doesn't exist
+func requireFeature(org *ent.Organization, name string) error {
+	if !FeatureEnabled(org, name) {
+		return echo.NewHTTPError(http.StatusNotFound, "this feature is not enabled for this organization")
+	}
+	return nil
+}
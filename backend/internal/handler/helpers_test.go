@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"backend/ent"
+	"backend/ent/enttest"
+	"backend/ent/organizationmember"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestClient opens a client on a fresh SQLite database with the schema migrated. The
+// database is a file rather than in memory so that background writes on other connections,
+// such as activity entries, see the same data. Transactions take the write lock when they
+// begin, since SQLite fails a transaction that tries to upgrade its lock while a background
+// write is waiting rather than letting it wait.
+func newTestClient(t testing.TB) *ent.Client {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") + "?_fk=1&_busy_timeout=5000&_txlock=immediate"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// testRequest describes a request to call a handler with directly
+type testRequest struct {
+	Method string
+	Path   string
+	Params map[string]string
+	Body   any
+	UserID uuid.UUID    // sets the authenticated user unless zero
+	Claims *auth.Claims // put into the request context for GET and HEAD, like the auth middleware
+}
+
+// newTestContext builds an echo context for r and returns it with its response recorder
+func newTestContext(t testing.TB, r testRequest) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+	method := r.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body bytes.Buffer
+	if r.Body != nil {
+		if err := json.NewEncoder(&body).Encode(r.Body); err != nil {
+			t.Fatalf("encoding request body: %v", err)
+		}
+	}
+	req := httptest.NewRequest(method, "/"+r.Path, &body)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if r.Claims != nil && (method == http.MethodGet || method == http.MethodHead) {
+		req = req.WithContext(auth.WithClaims(req.Context(), r.Claims))
+	}
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	var names, values []string
+	for name, value := range r.Params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	if r.UserID != uuid.Nil {
+		c.Set(auth.UserIDKey, r.UserID)
+	}
+	if r.Claims != nil {
+		c.Set(auth.UserClaimsKey, r.Claims)
+	}
+	return c, rec
+}
+
+// statusOf returns the status a handler responded with: the code of a returned HTTP error,
+// or the recorded status when it wrote a response
+func statusOf(t testing.TB, err error, rec *httptest.ResponseRecorder) int {
+	t.Helper()
+	if err == nil {
+		return rec.Code
+	}
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return he.Code
+	}
+	t.Fatalf("unexpected error: %v", err)
+	return 0
+}
+
+// decodeResponse decodes a recorded JSON response into v
+func decodeResponse(t testing.TB, rec *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("decoding response %q: %v", rec.Body.String(), err)
+	}
+}
+
+// createTestUser creates a user with the given email
+func createTestUser(t testing.TB, client *ent.Client, email string) *ent.User {
+	t.Helper()
+	u, err := client.User.Create().
+		SetEmail(email).
+		SetPasswordHash("unused").
+		SetDisplayName(email).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating user: %v", err)
+	}
+	return u
+}
+
+// createTestOrg creates an organization owned by owner
+func createTestOrg(t testing.TB, client *ent.Client, slug string, owner *ent.User) *ent.Organization {
+	t.Helper()
+	org, err := client.Organization.Create().
+		SetName(slug).
+		SetSlug(slug).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating organization: %v", err)
+	}
+	addTestMember(t, client, org, owner, organizationmember.RoleOwner)
+	return org
+}
+
+// addTestMember adds u to org with role
+func addTestMember(t testing.TB, client *ent.Client, org *ent.Organization, u *ent.User, role organizationmember.Role) *ent.OrganizationMember {
+	t.Helper()
+	m, err := client.OrganizationMember.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(u.ID).
+		SetRole(role).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("adding member: %v", err)
+	}
+	return m
+}
+
+// createTestProject creates a project in org
+func createTestProject(t testing.TB, client *ent.Client, org *ent.Organization, name string, private bool) *ent.Project {
+	t.Helper()
+	proj, err := client.Project.Create().
+		SetOrganizationID(org.ID).
+		SetName(name).
+		SetIsPrivate(private).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating project: %v", err)
+	}
+	return proj
+}
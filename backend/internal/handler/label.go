@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/label"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// CreateLabelRequest represents the request to create a project label
+type CreateLabelRequest struct {
+	Name  string `json:"name" validate:"required,max=50"`
+	Color string `json:"color" validate:"required"`
+}
+
+// LabelResponse represents a label in responses
+type LabelResponse struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"project_id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newLabelResponse(l *ent.Label) LabelResponse {
+	return LabelResponse{
+		ID:        l.ID,
+		ProjectID: l.ProjectID,
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+// orderLabels sorts eager-loaded labels by name
+func orderLabels(q *ent.LabelQuery) {
+	q.Order(ent.Asc(label.FieldName))
+}
+
+// CreateLabel creates a label in a project (edit permission required)
+func (h *TaskHandler) CreateLabel(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req CreateLabelRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	req.Color = strings.ToLower(strings.TrimSpace(req.Color))
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if strings.Contains(req.Name, ",") {
+		return echo.NewHTTPError(http.StatusBadRequest, "label name must not contain commas")
+	}
+	if err := label.ColorValidator(req.Color); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "color must be a hex color like #1a2b3c")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	exists, err := h.client.Label.Query().
+		Where(
+			label.ProjectIDEQ(access.Project.ID),
+			label.NameEQ(req.Name),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check label name")
+	}
+	if exists {
+		return echo.NewHTTPError(http.StatusConflict, "a label with this name already exists in this project")
+	}
+
+	l, err := h.client.Label.Create().
+		SetProjectID(access.Project.ID).
+		SetName(req.Name).
+		SetColor(req.Color).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "a label with this name already exists in this project")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create label")
+	}
+
+	return c.JSON(http.StatusCreated, newLabelResponse(l))
+}
+
+// ListLabels lists the labels of a project
+func (h *TaskHandler) ListLabels(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	labels, err := h.client.Label.Query().
+		Where(label.ProjectIDEQ(access.Project.ID)).
+		Order(ent.Asc(label.FieldName)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list labels")
+	}
+
+	result := make([]LabelResponse, len(labels))
+	for i, l := range labels {
+		result[i] = newLabelResponse(l)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// AttachLabel attaches one of the project's labels to a task
+func (h *TaskHandler) AttachLabel(c echo.Context) error {
+	return h.setTaskLabel(c, true)
+}
+
+// DetachLabel removes a label from a task
+func (h *TaskHandler) DetachLabel(c echo.Context) error {
+	return h.setTaskLabel(c, false)
+}
+
+// setTaskLabel attaches or detaches a label and returns the updated task. Both are
+// idempotent: attaching an attached label or detaching a missing one is not an error.
+func (h *TaskHandler) setTaskLabel(c echo.Context, attach bool) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	labelID, err := uuid.Parse(c.Param("label_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid label_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	// Only labels of the task's own project can be used
+	exists, err := h.client.Label.Query().
+		Where(
+			label.IDEQ(labelID),
+			label.ProjectIDEQ(access.Project.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get label")
+	}
+	if !exists {
+		return echo.NewHTTPError(http.StatusNotFound, "label not found")
+	}
+
+	attached := false
+	for _, l := range t.Edges.Labels {
+		if l.ID == labelID {
+			attached = true
+			break
+		}
+	}
+
+	if attach != attached {
+		update := h.client.Task.UpdateOneID(t.ID)
+		if attach {
+			update.AddLabelIDs(labelID)
+		} else {
+			update.RemoveLabelIDs(labelID)
+		}
+		// A constraint error means a concurrent request attached it first
+		if err := update.Exec(ctx); err != nil && !(attach && ent.IsConstraintError(err)) {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update task labels")
+		}
+	}
+
+	t, err = h.reloadTask(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
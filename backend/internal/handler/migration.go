@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// dedupeProjectNamesSQL renames every live project but the oldest among those sharing a name in
+// their organization by appending the start of its id, e.g. "Design (1a2b3c4d)". It does
+// nothing on a fresh database, where the projects table doesn't exist yet.
+const dedupeProjectNamesSQL = `DO $$
+BEGIN
+	IF to_regclass('projects') IS NOT NULL THEN
+		UPDATE projects AS p
+		SET name = p.name || ' (' || left(p.id::text, 8) || ')'
+		FROM (
+			SELECT id, row_number() OVER (PARTITION BY organization_id, name ORDER BY created_at, id) AS n
+			FROM projects
+			WHERE deleted_at IS NULL
+		) AS d
+		WHERE p.id = d.id AND d.n > 1;
+	END IF;
+END $$`
+
+// DedupeProjectNames is a migration apply hook that renames duplicate project names created
+// before names were unique within an organization, so the unique index can be built
+func DedupeProjectNames(next schema.Applier) schema.Applier {
+	return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+		if err := conn.Exec(ctx, dedupeProjectNamesSQL, []any{}, nil); err != nil {
+			return err
+		}
+		return next.Apply(ctx, conn, plan)
+	})
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"backend/ent"
+	"backend/ent/notification"
+	"backend/internal/auth"
+	"backend/internal/logging"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Notification types
+const (
+	NotificationInviteAccepted = "invite.accepted"
+	NotificationTaskAssigned   = "task.assigned"
+	NotificationMentioned      = "comment.mentioned"
+)
+
+// NotificationHandler handles the current user's in-app notifications
+type NotificationHandler struct {
+	client *ent.Client
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(client *ent.Client) *NotificationHandler {
+	return &NotificationHandler{client: client}
+}
+
+// notificationEntry describes a notification to create for a user about something an actor did
+type notificationEntry struct {
+	UserID  uuid.UUID
+	ActorID uuid.UUID
+	Type    string
+	Payload map[string]any
+}
+
+// notify creates a notification in the background, like recordActivity, adding the actor's id
+// and name to the payload. Users are not notified of their own actions.
+func notify(ctx context.Context, client *ent.Client, n notificationEntry) {
+	if n.UserID == n.ActorID {
+		return
+	}
+	logger := logging.FromContext(ctx)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		payload := map[string]any{"actor_id": n.ActorID}
+		for k, v := range n.Payload {
+			payload[k] = v
+		}
+		if actor, err := client.User.Get(ctx, n.ActorID); err == nil {
+			payload["actor_name"] = actor.DisplayName
+		}
+
+		_, err := client.Notification.Create().
+			SetUserID(n.UserID).
+			SetType(n.Type).
+			SetPayload(payload).
+			Save(ctx)
+		if err != nil {
+			logger.Error("failed to create notification", "type", n.Type, "user_id", n.UserID, "error", err)
+		}
+	}()
+}
+
+// countUnreadNotifications counts the notifications the user hasn't read yet
+func countUnreadNotifications(ctx context.Context, client *ent.Client, userID uuid.UUID) (int, error) {
+	return client.Notification.Query().
+		Where(
+			notification.UserIDEQ(userID),
+			notification.ReadAtIsNil(),
+		).
+		Count(ctx)
+}
+
+// NotificationResponse represents a notification in responses
+type NotificationResponse struct {
+	ID        uuid.UUID      `json:"id"`
+	Type      string         `json:"type"`
+	Payload   map[string]any `json:"payload,omitempty"`
+	ReadAt    *time.Time     `json:"read_at"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// NotificationListResponse represents a page of the current user's notifications
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unread_count"`
+	NextCursor    *string                `json:"next_cursor"`
+}
+
+var notificationSortFields = SortFields{
+	"created_at": {Column: notification.FieldCreatedAt},
+}
+
+// ListNotifications lists the current user's notifications, newest first, with the number of
+// unread ones. ?unread=true leaves out those already read.
+func (h *NotificationHandler) ListNotifications(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	spec, err := parseSort(c, notificationSortFields, "-created_at")
+	if err != nil {
+		return err
+	}
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+	after, err := parseCursor(c, spec)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	query := h.client.Notification.Query().
+		Where(notification.UserIDEQ(userID))
+	if c.QueryParam("unread") == "true" {
+		query.Where(notification.ReadAtIsNil())
+	}
+	if after != nil {
+		query.Where(after)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	notifications, err := query.
+		Order(spec.Order).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list notifications")
+	}
+
+	unread, err := countUnreadNotifications(ctx, h.client, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count unread notifications")
+	}
+
+	var nextCursor *string
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[len(notifications)-1]
+		nextCursor = encodeCursor(spec, []any{last.CreatedAt}, last.ID)
+	}
+
+	result := make([]NotificationResponse, len(notifications))
+	for i, n := range notifications {
+		result[i] = NotificationResponse{
+			ID:        n.ID,
+			Type:      n.Type,
+			Payload:   n.Payload,
+			ReadAt:    n.ReadAt,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+
+	return c.JSON(http.StatusOK, NotificationListResponse{
+		Notifications: result,
+		UnreadCount:   unread,
+		NextCursor:    nextCursor,
+	})
+}
+
+// MarkNotificationRead marks one of the current user's notifications as read
+func (h *NotificationHandler) MarkNotificationRead(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid notification id format")
+	}
+
+	ctx := c.Request().Context()
+
+	n, err := h.client.Notification.Query().
+		Where(
+			notification.IDEQ(id),
+			notification.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "notification not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get notification")
+	}
+
+	// Keep the time it was first read
+	if n.ReadAt == nil {
+		if err := n.Update().SetReadAt(time.Now()).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update notification")
+		}
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead marks all of the current user's unread notifications as read
+func (h *NotificationHandler) MarkAllNotificationsRead(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	_, err := h.client.Notification.Update().
+		Where(
+			notification.UserIDEQ(userID),
+			notification.ReadAtIsNil(),
+		).
+		SetReadAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update notifications")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,25 @@
+package handler
+
+import "encoding/json"
+
+// Nullable is a request field that distinguishes an omitted value from an explicit null.
+// Set is true whenever the field appeared in the body; Value is nil when it was null.
+type Nullable[T any] struct {
+	Set   bool
+	Value *T
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Value = nil
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n.Value = &v
+	return nil
+}
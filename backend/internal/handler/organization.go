@@ -1,69 +1,171 @@
 package handler
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"os"
 	"time"
 
 	"backend/ent"
+	"backend/ent/activity"
+	"backend/ent/apikey"
 	"backend/ent/invite"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
+	"backend/ent/project"
+	"backend/ent/projectmember"
+	"backend/ent/schema"
+	"backend/ent/user"
+	"backend/ent/webhook"
+	"backend/ent/webhookdelivery"
 	"backend/internal/auth"
+	"backend/internal/logging"
 	"backend/internal/service"
 
-	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
 // validate is the validator instance (shared from auth.go)
-var orgValidate = validator.New()
+var orgValidate = newValidator()
 
 // OrganizationHandler handles organization-related requests
 type OrganizationHandler struct {
 	client       *ent.Client
 	emailService *service.EmailService
+	inviteExpiry time.Duration
 }
 
-// NewOrganizationHandler creates a new organization handler
+// Invite lifetimes: the default, and the longest a configured or requested lifetime may be
+const (
+	defaultInviteExpiry = 7 * 24 * time.Hour
+	maxInviteExpiryDays = 30
+)
+
+// NewOrganizationHandler creates a new organization handler. Invites expire after
+// INVITE_EXPIRY (a duration such as "72h", at most 30 days), or 7 days when it is unset or invalid.
 func NewOrganizationHandler(client *ent.Client, emailService *service.EmailService) *OrganizationHandler {
+	inviteExpiry := defaultInviteExpiry
+	if d, err := time.ParseDuration(os.Getenv("INVITE_EXPIRY")); err == nil && d > 0 && d <= maxInviteExpiryDays*24*time.Hour {
+		inviteExpiry = d
+	}
+
 	return &OrganizationHandler{
 		client:       client,
 		emailService: emailService,
+		inviteExpiry: inviteExpiry,
+	}
+}
+
+// inviteExpiresAt returns when a new invite expires: after the requested number of days,
+// or the configured lifetime when none was requested
+func (h *OrganizationHandler) inviteExpiresAt(days *int) time.Time {
+	if days != nil {
+		return time.Now().AddDate(0, 0, *days)
+	}
+	return time.Now().Add(h.inviteExpiry)
+}
+
+// freeSeats returns how many more people fit under the organization's member limit, or
+// math.MaxInt when it has none. Seats are taken by members and, with countInvites, by pending
+// invites too.
+func freeSeats(ctx context.Context, client *ent.Client, org *ent.Organization, countInvites bool) (int, error) {
+	if org.MaxMembers == nil {
+		return math.MaxInt, nil
+	}
+
+	used, err := client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(org.ID)).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if countInvites {
+		pending, err := client.Invite.Query().
+			Where(
+				invite.OrganizationIDEQ(org.ID),
+				invite.UsedAtIsNil(),
+				invite.ExpiresAtGT(time.Now()),
+			).
+			Count(ctx)
+		if err != nil {
+			return 0, err
+		}
+		used += pending
+	}
+
+	return max(*org.MaxMembers-used, 0), nil
+}
+
+// requireFreeSeat rejects adding a member or invite to an organization that is full
+func requireFreeSeat(ctx context.Context, client *ent.Client, org *ent.Organization) error {
+	free, err := freeSeats(ctx, client, org, true)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count members").SetInternal(err)
 	}
+	if free == 0 {
+		return echo.NewHTTPError(http.StatusConflict, "organization has reached its member limit")
+	}
+	return nil
 }
 
-// CreateOrganizationRequest represents the request to create an organization
+// CreateOrganizationRequest represents the request to create an organization.
+// When Slug is omitted, one is derived from Name.
 type CreateOrganizationRequest struct {
 	Name string `json:"name" validate:"required"`
-	Slug string `json:"slug" validate:"required"`
+	Slug string `json:"slug"`
 }
 
 // OrganizationResponse represents the organization data in responses
 type OrganizationResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Slug      string    `json:"slug"`
-	Role      string    `json:"role,omitempty"`
-	CreatedAt time.Time `json:"created_at"`
+	ID                       uuid.UUID       `json:"id"`
+	Name                     string          `json:"name"`
+	Slug                     string          `json:"slug"`
+	Role                     string          `json:"role,omitempty"`
+	ReadOnly                 bool            `json:"read_only,omitempty"`
+	DefaultProjectPermission string          `json:"default_project_permission,omitempty"`
+	MaxMembers               *int            `json:"max_members,omitempty"` // Shown to the owner only
+	FeatureFlags             map[string]bool `json:"feature_flags,omitempty"`
+	CreatedAt                time.Time       `json:"created_at"`
 }
 
-// InviteRequest represents the request to invite a user
+// InviteRequest represents the request to invite a user.
+// Either Email (sends an invite link) or UserID (adds an existing user directly) must be set.
+// With ProjectID, the user is also added to that project with ProjectPermission (view by default).
+// ExpiresInDays overrides the default lifetime of an email invite.
 type InviteRequest struct {
-	Email     string  `json:"email" validate:"required,email"`
-	Role      string  `json:"role" validate:"required,oneof=admin member"`
-	ProjectID *string `json:"project_id,omitempty"`
+	Email             string  `json:"email" validate:"omitempty,email"`
+	UserID            *string `json:"user_id,omitempty"`
+	Role              string  `json:"role" validate:"required,oneof=admin member"`
+	ProjectID         *string `json:"project_id,omitempty"`
+	ProjectPermission string  `json:"project_permission" validate:"omitempty,oneof=edit view"`
+	ExpiresInDays     *int    `json:"expires_in_days,omitempty" validate:"omitempty,min=1,max=30"`
+}
+
+// MemberResponse represents an organization member in responses
+type MemberResponse struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Email       string    `json:"email"`
+	DisplayName string    `json:"display_name"`
+	Role        string    `json:"role"`
+	ReadOnly    bool      `json:"read_only"`
+	JoinedAt    time.Time `json:"joined_at"`
 }
 
 // InviteResponse represents the invite data in responses
 type InviteResponse struct {
-	ID        uuid.UUID  `json:"id"`
-	Email     string     `json:"email"`
-	Role      string     `json:"role"`
-	ExpiresAt time.Time  `json:"expires_at"`
-	CreatedAt time.Time  `json:"created_at"`
+	ID                uuid.UUID  `json:"id"`
+	Email             string     `json:"email"`
+	Role              string     `json:"role"`
+	ProjectID         *uuid.UUID `json:"project_id,omitempty"`
+	ProjectPermission string     `json:"project_permission,omitempty"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	CreatedAt         time.Time  `json:"created_at"`
 }
 
 // CreateOrganization creates a new organization
@@ -80,71 +182,86 @@ func (h *OrganizationHandler) CreateOrganization(c echo.Context) error {
 
 	// Validate request using validator
 	if err := orgValidate.Struct(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, formatValidationError(err))
+		return validationError(err)
 	}
 
 	ctx := c.Request().Context()
 
-	// Check if slug is already taken
-	exists, err := h.client.Organization.Query().
-		Where(organization.SlugEQ(req.Slug)).
-		Exist(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check slug availability")
-	}
-	if exists {
-		return echo.NewHTTPError(http.StatusConflict, "slug is already taken")
-	}
+	if req.Slug == "" {
+		slug, err := uniqueSlug(ctx, h.client, req.Name)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate slug").SetInternal(err)
+		}
+		req.Slug = slug
+	} else {
+		if err := organization.SlugValidator(req.Slug); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "slug must contain only lowercase letters, numbers, and hyphens")
+		}
+		if reservedSlugs[req.Slug] {
+			return echo.NewHTTPError(http.StatusBadRequest, "slug is reserved")
+		}
 
-	// Create organization in a transaction
-	tx, err := h.client.Tx(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to start transaction")
+		// Check if slug is already taken
+		exists, err := h.client.Organization.Query().
+			Where(organization.SlugEQ(req.Slug)).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check slug availability")
+		}
+		if exists {
+			return echo.NewHTTPError(http.StatusConflict, "slug is already taken")
+		}
 	}
 
-	// Create the organization
-	org, err := tx.Organization.Create().
-		SetName(req.Name).
-		SetSlug(req.Slug).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create organization")
-	}
+	// Create organization in a transaction
+	var org *ent.Organization
+	err := withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// Create the organization
+		var err error
+		org, err = tx.Organization.Create().
+			SetName(req.Name).
+			SetSlug(req.Slug).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "slug is already taken")
+		}
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create organization").SetInternal(err)
+		}
 
-	// Add creator as owner
-	_, err = tx.OrganizationMember.Create().
-		SetUserID(userID).
-		SetOrganizationID(org.ID).
-		SetRole(organizationmember.RoleOwner).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to add owner to organization")
-	}
+		// Add creator as owner
+		_, err = tx.OrganizationMember.Create().
+			SetUserID(userID).
+			SetOrganizationID(org.ID).
+			SetRole(organizationmember.RoleOwner).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to add owner to organization").SetInternal(err)
+		}
 
-	// Create default project
-	_, err = tx.Project.Create().
-		SetName("全般").
-		SetOrganizationID(org.ID).
-		SetIsPrivate(false).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create default project")
-	}
+		// Create default project
+		_, err = tx.Project.Create().
+			SetName(defaultProjectName).
+			SetOrganizationID(org.ID).
+			SetIsPrivate(false).
+			SetIsDefault(true).
+			SetPosition(positionGap).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create default project").SetInternal(err)
+		}
 
-	// Update user's last accessed org
-	_, err = tx.User.UpdateOneID(userID).
-		SetLastOrgID(org.ID).
-		Save(ctx)
+		// Update user's last accessed org
+		_, err = tx.User.UpdateOneID(userID).
+			SetLastOrgID(org.ID).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context").SetInternal(err)
+		}
+		return nil
+	})
 	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context")
-	}
-
-	if err := tx.Commit(); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit transaction")
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, OrganizationResponse{
@@ -156,6 +273,47 @@ func (h *OrganizationHandler) CreateOrganization(c echo.Context) error {
 	})
 }
 
+// Reasons a slug is unavailable
+const (
+	SlugInvalidFormat = "invalid_format"
+	SlugReserved      = "reserved"
+	SlugTaken         = "taken"
+)
+
+// SlugAvailabilityResponse reports whether a slug can be used for a new organization
+type SlugAvailabilityResponse struct {
+	Available bool   `json:"available"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// CheckSlug reports whether a slug is well-formed and free, so the UI can validate it
+// while the user types. It doesn't need a signed-in user.
+func (h *OrganizationHandler) CheckSlug(c echo.Context) error {
+	slug := c.QueryParam("slug")
+	if slug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
+	}
+
+	if err := organization.SlugValidator(slug); err != nil {
+		return c.JSON(http.StatusOK, SlugAvailabilityResponse{Reason: SlugInvalidFormat})
+	}
+	if reservedSlugs[slug] {
+		return c.JSON(http.StatusOK, SlugAvailabilityResponse{Reason: SlugReserved})
+	}
+
+	exists, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
+		Exist(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check slug availability")
+	}
+	if exists {
+		return c.JSON(http.StatusOK, SlugAvailabilityResponse{Reason: SlugTaken})
+	}
+
+	return c.JSON(http.StatusOK, SlugAvailabilityResponse{Available: true})
+}
+
 // ListOrganizations lists all organizations the user belongs to
 func (h *OrganizationHandler) ListOrganizations(c echo.Context) error {
 	userID, ok := auth.GetUserID(c)
@@ -165,12 +323,22 @@ func (h *OrganizationHandler) ListOrganizations(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	memberships, err := h.client.OrganizationMember.Query().
+	orgs, err := listUserOrganizations(ctx, h.client, userID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list organizations")
+	}
+
+	return c.JSON(http.StatusOK, orgs)
+}
+
+// listUserOrganizations returns the organizations the user belongs to along with their role
+func listUserOrganizations(ctx context.Context, client *ent.Client, userID uuid.UUID) ([]OrganizationResponse, error) {
+	memberships, err := client.OrganizationMember.Query().
 		Where(organizationmember.UserIDEQ(userID)).
 		WithOrganization().
 		All(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list organizations")
+		return nil, err
 	}
 
 	orgs := make([]OrganizationResponse, len(memberships))
@@ -180,11 +348,11 @@ func (h *OrganizationHandler) ListOrganizations(c echo.Context) error {
 			Name:      m.Edges.Organization.Name,
 			Slug:      m.Edges.Organization.Slug,
 			Role:      string(m.Role),
+			ReadOnly:  m.ReadOnly,
 			CreatedAt: m.Edges.Organization.CreatedAt,
 		}
 	}
-
-	return c.JSON(http.StatusOK, orgs)
+	return orgs, nil
 }
 
 // GetOrganization gets an organization by slug
@@ -232,16 +400,237 @@ func (h *OrganizationHandler) GetOrganization(c echo.Context) error {
 		Save(ctx)
 
 	return c.JSON(http.StatusOK, OrganizationResponse{
-		ID:        org.ID,
-		Name:      org.Name,
-		Slug:      org.Slug,
-		Role:      string(membership.Role),
-		CreatedAt: org.CreatedAt,
+		ID:                       org.ID,
+		Name:                     org.Name,
+		Slug:                     org.Slug,
+		Role:                     string(membership.Role),
+		ReadOnly:                 membership.ReadOnly,
+		DefaultProjectPermission: string(org.DefaultProjectPermission),
+		MaxMembers:               ownerMaxMembers(org, membership),
+		FeatureFlags:             resolveFeatureFlags(org),
+		CreatedAt:                org.CreatedAt,
 	})
 }
 
-// InviteMember invites a user to an organization
-func (h *OrganizationHandler) InviteMember(c echo.Context) error {
+// ownerMaxMembers returns the organization's member limit for the owner, who alone manages it
+func ownerMaxMembers(org *ent.Organization, membership *ent.OrganizationMember) *int {
+	if !IsOwner(membership.Role) {
+		return nil
+	}
+	return org.MaxMembers
+}
+
+// UpdateOrganizationRequest represents the request to update an organization.
+// Omitted fields are left unchanged; a null max_members removes the member limit.
+type UpdateOrganizationRequest struct {
+	Name                     *string       `json:"name" validate:"omitempty,min=1"`
+	Slug                     *string       `json:"slug"`
+	DefaultProjectPermission *string       `json:"default_project_permission" validate:"omitempty,oneof=view edit"`
+	MaxMembers               Nullable[int] `json:"max_members"`
+}
+
+// UpdateOrganization renames an organization or changes its slug (owner/admin only). The
+// default project permission and the member limit can only be changed by the owner. A limit
+// below the current number of members is allowed; it only stops new members from joining.
+func (h *OrganizationHandler) UpdateOrganization(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req UpdateOrganizationRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	if req.Slug != nil {
+		if err := organization.SlugValidator(*req.Slug); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "slug must contain only lowercase letters, numbers, and hyphens")
+		}
+		if reservedSlugs[*req.Slug] {
+			return echo.NewHTTPError(http.StatusBadRequest, "slug is reserved")
+		}
+	}
+	if req.MaxMembers.Value != nil && *req.MaxMembers.Value < 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "max_members must be at least 1")
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can update the organization")
+	}
+	if req.DefaultProjectPermission != nil && !IsOwner(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only the owner can change the default project permission")
+	}
+	if req.MaxMembers.Set && !IsOwner(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only the owner can change the member limit")
+	}
+
+	update := org.Update()
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.Slug != nil && *req.Slug != org.Slug {
+		// Check if slug is already taken
+		exists, err := h.client.Organization.Query().
+			Where(organization.SlugEQ(*req.Slug)).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check slug availability")
+		}
+		if exists {
+			return echo.NewHTTPError(http.StatusConflict, "slug is already taken")
+		}
+		update.SetSlug(*req.Slug)
+	}
+	if req.DefaultProjectPermission != nil {
+		update.SetDefaultProjectPermission(organization.DefaultProjectPermission(*req.DefaultProjectPermission))
+	}
+	if req.MaxMembers.Set {
+		if req.MaxMembers.Value == nil {
+			update.ClearMaxMembers()
+		} else {
+			update.SetMaxMembers(*req.MaxMembers.Value)
+		}
+	}
+
+	org, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "slug is already taken")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update organization")
+	}
+
+	return c.JSON(http.StatusOK, OrganizationResponse{
+		ID:                       org.ID,
+		Name:                     org.Name,
+		Slug:                     org.Slug,
+		Role:                     string(membership.Role),
+		ReadOnly:                 membership.ReadOnly,
+		DefaultProjectPermission: string(org.DefaultProjectPermission),
+		MaxMembers:               ownerMaxMembers(org, membership),
+		FeatureFlags:             resolveFeatureFlags(org),
+		CreatedAt:                org.CreatedAt,
+	})
+}
+
+// DeleteOrganization deletes an organization with all of its projects, tasks, members and invites (owner only)
+func (h *OrganizationHandler) DeleteOrganization(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !IsOwner(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners can delete the organization")
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// Soft-deleted projects are removed for good along with the rest
+		projectIDs, err := tx.Project.Query().
+			Where(project.OrganizationIDEQ(org.ID)).
+			IDs(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to list projects").SetInternal(err)
+		}
+
+		if err := deleteProjectsTx(ctx, tx, projectIDs); err != nil {
+			return err
+		}
+
+		// Users who last accessed this organization start from the organization picker
+		if _, err := tx.User.Update().
+			Where(user.LastOrgIDEQ(org.ID)).
+			ClearLastOrgID().
+			ClearLastProjectID().
+			Save(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to clear user context").SetInternal(err)
+		}
+
+		if _, err := tx.Invite.Delete().
+			Where(invite.OrganizationIDEQ(org.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete invites").SetInternal(err)
+		}
+
+		if _, err := tx.OrganizationMember.Delete().
+			Where(organizationmember.OrganizationIDEQ(org.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete members").SetInternal(err)
+		}
+
+		if _, err := tx.WebhookDelivery.Delete().
+			Where(webhookdelivery.HasWebhookWith(webhook.OrganizationIDEQ(org.ID))).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete webhook deliveries").SetInternal(err)
+		}
+		if _, err := tx.Webhook.Delete().
+			Where(webhook.OrganizationIDEQ(org.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete webhooks").SetInternal(err)
+		}
+
+		if _, err := tx.APIKey.Delete().
+			Where(apikey.OrganizationIDEQ(org.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete api keys").SetInternal(err)
+		}
+
+		if _, err := tx.Activity.Delete().
+			Where(activity.OrganizationIDEQ(org.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete activity").SetInternal(err)
+		}
+
+		if err := tx.Organization.DeleteOneID(org.ID).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete organization").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("organization deleted", "organization_slug", org.Slug, "organization_id", org.ID, "user_id", userID)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UpdateFeatureFlags toggles feature flags for an organization (owner only)
+func (h *OrganizationHandler) UpdateFeatureFlags(c echo.Context) error {
 	userID, ok := auth.GetUserID(c)
 	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
@@ -252,14 +641,14 @@ func (h *OrganizationHandler) InviteMember(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
 	}
 
-	var req InviteRequest
+	var req map[string]bool
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
-
-	// Validate request using validator
-	if err := orgValidate.Struct(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, formatValidationError(err))
+	for name := range req {
+		if _, ok := knownFeatures[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown feature flag: "+name)
+		}
 	}
 
 	ctx := c.Request().Context()
@@ -275,7 +664,7 @@ func (h *OrganizationHandler) InviteMember(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
 	}
 
-	// Check if user has permission to invite (must be owner or admin)
+	// Check membership
 	membership, err := h.client.OrganizationMember.Query().
 		Where(
 			organizationmember.UserIDEQ(userID),
@@ -289,152 +678,1188 @@ func (h *OrganizationHandler) InviteMember(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
 	}
 
-	if !HasAdminPermission(membership.Role) {
-		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can invite members")
+	if err := requireWriteAccess(membership); err != nil {
+		return err
 	}
 
-	// Generate invite token
-	tokenBytes := make([]byte, 32)
-	if _, err := rand.Read(tokenBytes); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate invite token")
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !IsOwner(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners can change feature flags")
 	}
-	token := hex.EncodeToString(tokenBytes)
 
-	// Determine role
-	role := invite.RoleMember
-	if req.Role == "admin" {
-		role = invite.RoleAdmin
+	flags := make(map[string]bool, len(org.FeatureFlags)+len(req))
+	for name, v := range org.FeatureFlags {
+		flags[name] = v
+	}
+	for name, v := range req {
+		flags[name] = v
 	}
 
-	// Create invite
-	inv, err := h.client.Invite.Create().
-		SetToken(token).
-		SetEmail(req.Email).
-		SetOrganizationID(org.ID).
-		SetRole(role).
-		SetInvitedByID(userID).
-		SetExpiresAt(time.Now().Add(7 * 24 * time.Hour)).
+	org, err = h.client.Organization.UpdateOne(org).
+		SetFeatureFlags(flags).
 		Save(ctx)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create invite")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update feature flags")
 	}
 
-	// Get inviter name
-	inviter, _ := h.client.User.Get(ctx, userID)
-	inviterName := "Someone"
-	if inviter != nil {
-		inviterName = inviter.DisplayName
+	return c.JSON(http.StatusOK, resolveFeatureFlags(org))
+}
+
+// MyMembershipResponse describes the caller's membership in an organization and the features
+// enabled for it, so that the frontend can hide what the caller can't use
+type MyMembershipResponse struct {
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Role           string          `json:"role"`
+	ReadOnly       bool            `json:"read_only"`
+	FeatureFlags   map[string]bool `json:"feature_flags"`
+}
+
+// GetMyMembership returns the caller's role in the organization and its resolved feature flags
+func (h *OrganizationHandler) GetMyMembership(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	// Send invite email
-	go func() {
-		_ = h.emailService.SendInviteEmail(ctx, req.Email, inviterName, org.Name, token)
-	}()
+	org, membership, err := loadOrgMembership(c.Request().Context(), h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
 
-	return c.JSON(http.StatusCreated, InviteResponse{
-		ID:        inv.ID,
-		Email:     inv.Email,
-		Role:      string(inv.Role),
-		ExpiresAt: inv.ExpiresAt,
-		CreatedAt: inv.CreatedAt,
+	return c.JSON(http.StatusOK, MyMembershipResponse{
+		OrganizationID: org.ID,
+		Role:           string(membership.Role),
+		ReadOnly:       membership.ReadOnly,
+		FeatureFlags:   resolveFeatureFlags(org),
 	})
 }
 
-// AcceptInvite accepts an invite and joins the organization
-func (h *OrganizationHandler) AcceptInvite(c echo.Context) error {
+// MemberStatsResponse represents member counts of an organization
+type MemberStatsResponse struct {
+	Total          int `json:"total"`
+	Owners         int `json:"owners"`
+	Admins         int `json:"admins"`
+	Members        int `json:"members"`
+	PendingInvites int `json:"pending_invites"`
+}
+
+// GetMemberStats returns member counts by role and the number of pending invites.
+// Any member of the organization can view these counts.
+func (h *OrganizationHandler) GetMemberStats(c echo.Context) error {
 	userID, ok := auth.GetUserID(c)
 	if !ok {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	token := c.Param("token")
-	if token == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "token is required")
+	slug := c.Param("slug")
+	if slug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
 	}
 
 	ctx := c.Request().Context()
 
-	// Get invite
-	inv, err := h.client.Invite.Query().
-		Where(
-			invite.TokenEQ(token),
-			invite.UsedAtIsNil(),
-			invite.ExpiresAtGT(time.Now()),
-		).
-		WithOrganization().
+	// Get organization
+	org, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound, "invite not found or expired")
+			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
 		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
 	}
 
-	// Check if user is already a member
+	// Check membership
 	exists, err := h.client.OrganizationMember.Query().
 		Where(
 			organizationmember.UserIDEQ(userID),
-			organizationmember.OrganizationIDEQ(inv.OrganizationID),
+			organizationmember.OrganizationIDEQ(org.ID),
 		).
 		Exist(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
 	}
-	if exists {
-		return echo.NewHTTPError(http.StatusConflict, "you are already a member of this organization")
+	if !exists {
+		return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
 	}
 
-	// Transaction: add member and mark invite as used
-	tx, err := h.client.Tx(ctx)
+	// Count members grouped by role
+	var roleCounts []struct {
+		Role  organizationmember.Role `json:"role"`
+		Count int                     `json:"count"`
+	}
+	err = h.client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(org.ID)).
+		GroupBy(organizationmember.FieldRole).
+		Aggregate(ent.Count()).
+		Scan(ctx, &roleCounts)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count members")
+	}
+
+	var stats MemberStatsResponse
+	for _, rc := range roleCounts {
+		switch rc.Role {
+		case organizationmember.RoleOwner:
+			stats.Owners = rc.Count
+		case organizationmember.RoleAdmin:
+			stats.Admins = rc.Count
+		case organizationmember.RoleMember:
+			stats.Members = rc.Count
+		}
+		stats.Total += rc.Count
+	}
+
+	// Count pending (unused and unexpired) invites
+	stats.PendingInvites, err = h.client.Invite.Query().
+		Where(
+			invite.OrganizationIDEQ(org.ID),
+			invite.UsedAtIsNil(),
+			invite.ExpiresAtGT(time.Now()),
+		).
+		Count(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count invites")
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// InviteMember invites a user to an organization
+func (h *OrganizationHandler) InviteMember(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	slug := c.Param("slug")
+	if slug == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "slug is required")
+	}
+
+	var req InviteRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.Email = normalizeEmail(req.Email)
+
+	// Validate request using validator
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if (req.Email == "") == (req.UserID == nil) {
+		return echo.NewHTTPError(http.StatusBadRequest, "either email or user_id is required")
+	}
+	if req.ProjectPermission != "" && req.ProjectID == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "project_permission requires project_id")
+	}
+
+	ctx := c.Request().Context()
+
+	// Get organization
+	org, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+	}
+
+	// Check if user has permission to invite (must be owner or admin)
+	membership, err := h.client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can invite members")
+	}
+
+	var proj *ent.Project
+	if req.ProjectID != nil {
+		proj, err = orgProject(ctx, h.client, org.ID, *req.ProjectID)
+		if err != nil {
+			return err
+		}
+	}
+	permission := projectmember.PermissionView
+	if req.ProjectPermission == "edit" {
+		permission = projectmember.PermissionEdit
+	}
+
+	// Existing platform users can be added directly without the accept step
+	if req.UserID != nil {
+		return h.addExistingUser(c, org, userID, *req.UserID, req.Role, proj, permission)
+	}
+
+	// Don't send an invite to someone who is already in the organization
+	isMember, err := h.client.User.Query().
+		Where(
+			user.EmailEqualFold(req.Email),
+			user.HasOrganizationMembershipsWith(organizationmember.OrganizationIDEQ(org.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check existing members")
+	}
+	if isMember {
+		return echo.NewHTTPError(http.StatusConflict, "this email already belongs to a member of this organization")
+	}
+
+	// Inviting the same email again returns the pending invite rather than sending another
+	pending, err := h.client.Invite.Query().
+		Where(
+			invite.OrganizationIDEQ(org.ID),
+			invite.EmailEQ(req.Email),
+			invite.UsedAtIsNil(),
+			invite.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(invite.FieldCreatedAt)).
+		First(ctx)
+	if err == nil {
+		return c.JSON(http.StatusOK, newInviteResponse(pending))
+	}
+	if !ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check pending invites")
+	}
+
+	if err := requireFreeSeat(ctx, h.client, org); err != nil {
+		return err
+	}
+
+	// Generate invite token
+	token, err := newInviteToken()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate invite token")
+	}
+
+	// Determine role
+	role := invite.RoleMember
+	if req.Role == "admin" {
+		role = invite.RoleAdmin
+	}
+
+	// Create invite
+	create := h.client.Invite.Create().
+		SetToken(token).
+		SetEmail(req.Email).
+		SetOrganizationID(org.ID).
+		SetRole(role).
+		SetInvitedByID(userID).
+		SetExpiresAt(h.inviteExpiresAt(req.ExpiresInDays))
+	if proj != nil {
+		create.
+			SetProjectID(proj.ID).
+			SetProjectPermission(invite.ProjectPermission(permission))
+	}
+	inv, err := create.Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create invite")
+	}
+
+	// Get inviter name
+	inviter, _ := h.client.User.Get(ctx, userID)
+	inviterName := "Someone"
+	if inviter != nil {
+		inviterName = inviter.DisplayName
+	}
+
+	// Queue the invite email; the invite can still be resent or its link shared if this fails
+	if err := h.emailService.SendInviteEmail(ctx, requestLocale(c), req.Email, inviterName, org.Name, token); err != nil {
+		logging.FromContext(ctx).Error("failed to send invite email", "invite_id", inv.ID, "error", err)
+	}
+
+	recordActivity(ctx, h.client, inviteActivity(org.ID, userID, inv))
+
+	return c.JSON(http.StatusCreated, newInviteResponse(inv))
+}
+
+// newInviteResponse converts an invite to its response
+func newInviteResponse(inv *ent.Invite) InviteResponse {
+	return InviteResponse{
+		ID:                inv.ID,
+		Email:             inv.Email,
+		Role:              string(inv.Role),
+		ProjectID:         inv.ProjectID,
+		ProjectPermission: inviteProjectPermission(inv),
+		ExpiresAt:         inv.ExpiresAt,
+		CreatedAt:         inv.CreatedAt,
+	}
+}
+
+// inviteProjectPermission returns the project permission a project-scoped invite grants,
+// or "" for an organization-wide invite
+func inviteProjectPermission(inv *ent.Invite) string {
+	if inv.ProjectID == nil {
+		return ""
+	}
+	if inv.ProjectPermission == nil {
+		return string(invite.ProjectPermissionView)
+	}
+	return string(*inv.ProjectPermission)
+}
+
+// orgProject finds a project of an organization by the id given in a request
+func orgProject(ctx context.Context, client *ent.Client, orgID uuid.UUID, projectIDStr string) (*ent.Project, error) {
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid project_id format")
+	}
+	proj, err := client.Project.Query().
+		Where(
+			project.IDEQ(projectID),
+			project.OrganizationIDEQ(orgID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get project")
+	}
+	return proj, nil
+}
+
+// grantProjectAccess adds a user to a project with the given permission. A user who is
+// already a project member keeps their current permission.
+func grantProjectAccess(ctx context.Context, tx *ent.Tx, projectID, userID uuid.UUID, permission projectmember.Permission) error {
+	exists, err := tx.ProjectMember.Query().
+		Where(
+			projectmember.ProjectIDEQ(projectID),
+			projectmember.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	return tx.ProjectMember.Create().
+		SetUserID(userID).
+		SetProjectID(projectID).
+		SetPermission(permission).
+		Exec(ctx)
+}
+
+// newInviteToken generates a random token for an invite link
+func newInviteToken() (string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(tokenBytes), nil
+}
+
+// maxBulkInvites caps the number of distinct emails in one bulk invite request
+const maxBulkInvites = 50
+
+// Outcomes reported for each email of a bulk invite
+const (
+	bulkInviteCreated = "created"
+	bulkInviteSkipped = "skipped"
+	bulkInviteFailed  = "failed"
+)
+
+// BulkInviteRequest represents the request to invite several emails at once
+type BulkInviteRequest struct {
+	Emails        []string `json:"emails" validate:"required,min=1"`
+	Role          string   `json:"role" validate:"required,oneof=admin member"`
+	ExpiresInDays *int     `json:"expires_in_days,omitempty" validate:"omitempty,min=1,max=30"`
+}
+
+// BulkInviteResult represents the outcome for one email of a bulk invite
+type BulkInviteResult struct {
+	Email  string          `json:"email"`
+	Status string          `json:"status"`
+	Reason string          `json:"reason,omitempty"`
+	Invite *InviteResponse `json:"invite,omitempty"`
+}
+
+// BulkInviteResponse represents the response of a bulk invite
+type BulkInviteResponse struct {
+	Results []BulkInviteResult `json:"results"`
+}
+
+// BulkInvite invites a list of emails to the organization (owner/admin only). Duplicate
+// emails are collapsed; existing members and pending invitees are skipped, and emails past
+// the organization's member limit fail.
+func (h *OrganizationHandler) BulkInvite(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req BulkInviteRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	// Deduplicate while keeping the order the emails were given in
+	seen := make(map[string]bool, len(req.Emails))
+	var emails []string
+	for _, raw := range req.Emails {
+		email := normalizeEmail(raw)
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, email)
+	}
+	if len(emails) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "at least one email is required")
+	}
+	if len(emails) > maxBulkInvites {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("at most %d emails can be invited at once", maxBulkInvites))
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can invite members")
+	}
+
+	members, err := h.client.User.Query().
+		Where(
+			user.EmailIn(emails...),
+			user.HasOrganizationMembershipsWith(organizationmember.OrganizationIDEQ(org.ID)),
+		).
+		Select(user.FieldEmail).
+		Strings(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check existing members")
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, email := range members {
+		isMember[email] = true
+	}
+
+	pending, err := h.client.Invite.Query().
+		Where(
+			invite.OrganizationIDEQ(org.ID),
+			invite.EmailIn(emails...),
+			invite.UsedAtIsNil(),
+			invite.ExpiresAtGT(time.Now()),
+		).
+		Select(invite.FieldEmail).
+		Strings(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check pending invites")
+	}
+	isInvited := make(map[string]bool, len(pending))
+	for _, email := range pending {
+		isInvited[email] = true
+	}
+
+	// Invites are created in order until the organization is full
+	free, err := freeSeats(ctx, h.client, org, true)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count members").SetInternal(err)
+	}
+	if free == 0 {
+		return echo.NewHTTPError(http.StatusConflict, "organization has reached its member limit")
+	}
+
+	role := invite.RoleMember
+	if req.Role == "admin" {
+		role = invite.RoleAdmin
+	}
+
+	inviterName := "Someone"
+	if inviter, _ := h.client.User.Get(ctx, userID); inviter != nil {
+		inviterName = inviter.DisplayName
+	}
+
+	locale := requestLocale(c)
+	results := make([]BulkInviteResult, len(emails))
+	for i, email := range emails {
+		results[i] = BulkInviteResult{Email: email}
+
+		switch {
+		case orgValidate.Var(email, "email") != nil:
+			results[i].Status = bulkInviteFailed
+			results[i].Reason = "invalid email address"
+			continue
+		case isMember[email]:
+			results[i].Status = bulkInviteSkipped
+			results[i].Reason = "already a member"
+			continue
+		case isInvited[email]:
+			results[i].Status = bulkInviteSkipped
+			results[i].Reason = "already invited"
+			continue
+		case free == 0:
+			results[i].Status = bulkInviteFailed
+			results[i].Reason = "organization has reached its member limit"
+			continue
+		}
+
+		token, err := newInviteToken()
+		if err != nil {
+			results[i].Status = bulkInviteFailed
+			results[i].Reason = "failed to generate invite token"
+			continue
+		}
+
+		inv, err := h.client.Invite.Create().
+			SetToken(token).
+			SetEmail(email).
+			SetOrganizationID(org.ID).
+			SetRole(role).
+			SetInvitedByID(userID).
+			SetExpiresAt(h.inviteExpiresAt(req.ExpiresInDays)).
+			Save(ctx)
+		if err != nil {
+			results[i].Status = bulkInviteFailed
+			results[i].Reason = "failed to create invite"
+			continue
+		}
+
+		free--
+
+		if err := h.emailService.SendInviteEmail(ctx, locale, email, inviterName, org.Name, token); err != nil {
+			logging.FromContext(ctx).Error("failed to send invite email", "invite_id", inv.ID, "error", err)
+		}
+
+		recordActivity(ctx, h.client, inviteActivity(org.ID, userID, inv))
+
+		results[i].Status = bulkInviteCreated
+		resp := newInviteResponse(inv)
+		results[i].Invite = &resp
+	}
+
+	return c.JSON(http.StatusOK, BulkInviteResponse{Results: results})
+}
+
+// addExistingUser adds an existing user to the organization directly and notifies them by email
+func (h *OrganizationHandler) addExistingUser(c echo.Context, org *ent.Organization, inviterID uuid.UUID, targetUserIDStr, roleStr string, proj *ent.Project, permission projectmember.Permission) error {
+	targetUserID, err := uuid.Parse(targetUserIDStr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid user_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	target, err := h.client.User.Get(ctx, targetUserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+	}
+	if target.DeletedAt != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "user not found")
+	}
+
+	// Check if already a member
+	exists, err := h.client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(targetUserID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check existing membership")
+	}
+	if exists {
+		return echo.NewHTTPError(http.StatusConflict, "user is already a member of this organization")
+	}
+
+	if err := requireFreeSeat(ctx, h.client, org); err != nil {
+		return err
+	}
+
+	role := organizationmember.RoleMember
+	if roleStr == "admin" {
+		role = organizationmember.RoleAdmin
+	}
+
+	var m *ent.OrganizationMember
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		var err error
+		m, err = tx.OrganizationMember.Create().
+			SetUserID(targetUserID).
+			SetOrganizationID(org.ID).
+			SetRole(role).
+			Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return echo.NewHTTPError(http.StatusConflict, "user is already a member of this organization")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to add member").SetInternal(err)
+		}
+
+		if proj != nil {
+			if err := grantProjectAccess(ctx, tx, proj.ID, targetUserID, permission); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to add project member").SetInternal(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Get inviter name
+	inviter, _ := h.client.User.Get(ctx, inviterID)
+	inviterName := "Someone"
+	if inviter != nil {
+		inviterName = inviter.DisplayName
+	}
+
+	// Notify the added user
+	if err := h.emailService.SendAddedToOrganizationEmail(ctx, requestLocale(c), target.Email, inviterName, org.Name, org.Slug); err != nil {
+		logging.FromContext(ctx).Error("failed to send added to organization email", "user_id", target.ID, "error", err)
+	}
+
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      org.ID,
+		ActorID:    inviterID,
+		Action:     ActivityMemberAdded,
+		TargetType: ActivityTargetUser,
+		TargetID:   target.ID,
+		Metadata: map[string]any{
+			"display_name": target.DisplayName,
+			"role":         string(m.Role),
+		},
+	})
+
+	return c.JSON(http.StatusCreated, MemberResponse{
+		UserID:      target.ID,
+		Email:       target.Email,
+		DisplayName: target.DisplayName,
+		Role:        string(m.Role),
+		ReadOnly:    m.ReadOnly,
+		JoinedAt:    m.CreatedAt,
+	})
+}
+
+// PendingInviteResponse represents an outstanding invite in the organization's invite list
+type PendingInviteResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	Email             string     `json:"email"`
+	Role              string     `json:"role"`
+	ProjectID         *uuid.UUID `json:"project_id,omitempty"`
+	ProjectPermission string     `json:"project_permission,omitempty"`
+	InvitedByID       uuid.UUID  `json:"invited_by_id"`
+	InvitedByName     string     `json:"invited_by_name"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// ListInvites lists the organization's unused, unexpired invites (owner/admin only)
+func (h *OrganizationHandler) ListInvites(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to start transaction")
+		return err
 	}
 
-	// Add user as member
-	role := organizationmember.RoleMember
-	if inv.Role == invite.RoleAdmin {
-		role = organizationmember.RoleAdmin
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can view invites")
 	}
 
-	_, err = tx.OrganizationMember.Create().
-		SetUserID(userID).
-		SetOrganizationID(inv.OrganizationID).
-		SetRole(role).
-		Save(ctx)
+	invites, err := h.client.Invite.Query().
+		Where(
+			invite.OrganizationIDEQ(org.ID),
+			invite.UsedAtIsNil(),
+			invite.ExpiresAtGT(time.Now()),
+		).
+		WithInvitedBy().
+		Order(ent.Desc(invite.FieldCreatedAt)).
+		All(ctx)
 	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to add member")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list invites")
 	}
 
-	// Mark invite as used
-	_, err = tx.Invite.UpdateOne(inv).
-		SetUsedAt(time.Now()).
-		Save(ctx)
+	result := make([]PendingInviteResponse, len(invites))
+	for i, inv := range invites {
+		result[i] = PendingInviteResponse{
+			ID:                inv.ID,
+			Email:             inv.Email,
+			Role:              string(inv.Role),
+			ProjectID:         inv.ProjectID,
+			ProjectPermission: inviteProjectPermission(inv),
+			InvitedByID:       inv.InvitedByID,
+			InvitedByName:     inv.Edges.InvitedBy.DisplayName,
+			ExpiresAt:         inv.ExpiresAt,
+			CreatedAt:         inv.CreatedAt,
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// IncomingInviteResponse represents an invite addressed to the current user
+type IncomingInviteResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	Token             string     `json:"token"`
+	OrganizationName  string     `json:"organization_name"`
+	OrganizationSlug  string     `json:"organization_slug"`
+	InvitedByName     string     `json:"invited_by_name"`
+	Role              string     `json:"role"`
+	ProjectID         *uuid.UUID `json:"project_id,omitempty"`
+	ProjectPermission string     `json:"project_permission,omitempty"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// ListMyInvites lists the unused, unexpired invites sent to the current user's email, newest
+// first, so that they can be accepted without the email link. Invites to organizations the
+// user has joined since are left out.
+func (h *OrganizationHandler) ListMyInvites(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	u, err := h.client.User.Get(ctx, userID)
 	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update invite")
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "user not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
 	}
 
-	// Update user's last accessed org
-	_, err = tx.User.UpdateOneID(userID).
-		SetLastOrgID(inv.OrganizationID).
+	invites, err := h.client.Invite.Query().
+		Where(
+			invite.EmailEQ(normalizeEmail(u.Email)),
+			invite.UsedAtIsNil(),
+			invite.ExpiresAtGT(time.Now()),
+			invite.Not(invite.HasOrganizationWith(
+				organization.HasMembersWith(user.IDEQ(userID)),
+			)),
+		).
+		WithOrganization().
+		WithInvitedBy().
+		Order(ent.Desc(invite.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list invites")
+	}
+
+	result := make([]IncomingInviteResponse, len(invites))
+	for i, inv := range invites {
+		result[i] = IncomingInviteResponse{
+			ID:                inv.ID,
+			Token:             inv.Token,
+			OrganizationName:  inv.Edges.Organization.Name,
+			OrganizationSlug:  inv.Edges.Organization.Slug,
+			InvitedByName:     inv.Edges.InvitedBy.DisplayName,
+			Role:              string(inv.Role),
+			ProjectID:         inv.ProjectID,
+			ProjectPermission: inviteProjectPermission(inv),
+			ExpiresAt:         inv.ExpiresAt,
+			CreatedAt:         inv.CreatedAt,
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RevokeInvite deletes a pending invite so that its link can no longer be accepted (owner/admin only)
+func (h *OrganizationHandler) RevokeInvite(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	inviteID, err := uuid.Parse(c.Param("invite_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid invite_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can revoke invites")
+	}
+
+	// Accepted invites are history rather than pending, so they cannot be revoked
+	n, err := h.client.Invite.Delete().
+		Where(
+			invite.IDEQ(inviteID),
+			invite.OrganizationIDEQ(org.ID),
+			invite.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to revoke invite")
+	}
+	if n == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "invite not found")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// SetMemberReadOnlyRequest represents the request to toggle a member's read-only access
+type SetMemberReadOnlyRequest struct {
+	ReadOnly *bool `json:"read_only" validate:"required"`
+}
+
+// SetMemberReadOnly makes a member read-only or restores their write access (owner only)
+func (h *OrganizationHandler) SetMemberReadOnly(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	slug := c.Param("slug")
+	targetUserIDStr := c.Param("user_id")
+	if slug == "" || targetUserIDStr == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "organization slug and user_id are required")
+	}
+
+	targetUserID, err := uuid.Parse(targetUserIDStr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid user_id format")
+	}
+
+	var req SetMemberReadOnlyRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	if targetUserID == userID {
+		return echo.NewHTTPError(http.StatusBadRequest, "you cannot change your own read-only setting")
+	}
+
+	ctx := c.Request().Context()
+
+	// Get organization
+	org, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+	}
+
+	// Check if requester is owner
+	membership, err := h.client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !IsOwner(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners can change read-only access")
+	}
+
+	// Get target membership
+	target, err := h.client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(targetUserID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "member not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get member")
+	}
+
+	updated, err := target.Update().
+		SetReadOnly(*req.ReadOnly).
 		Save(ctx)
 	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update member")
+	}
+
+	return c.JSON(http.StatusOK, MemberResponse{
+		UserID:      target.Edges.User.ID,
+		Email:       target.Edges.User.Email,
+		DisplayName: target.Edges.User.DisplayName,
+		Role:        string(updated.Role),
+		ReadOnly:    updated.ReadOnly,
+		JoinedAt:    updated.CreatedAt,
+	})
+}
+
+// AcceptInviteResponse represents the joined organization and the projects the invite grants access to
+type AcceptInviteResponse struct {
+	OrganizationResponse
+	ProjectScope string                `json:"project_scope"`
+	Projects     []InviteProjectAccess `json:"projects"`
+}
+
+// errInviteAlreadyUsed aborts the accept transaction when the invite was consumed concurrently
+var errInviteAlreadyUsed = errors.New("invite already used")
+
+// AcceptInvite accepts an invite and joins the organization. The invite already holds a
+// seat, so only members are counted against the member limit: when the limit was lowered
+// after invites went out, they are honored first come, first served.
+func (h *OrganizationHandler) AcceptInvite(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	token := c.Param("token")
+	if token == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "token is required")
+	}
+
+	ctx := c.Request().Context()
+
+	// Get invite (used invites are included so that a repeated accept can be answered)
+	inv, err := h.client.Invite.Query().
+		Where(invite.TokenEQ(token)).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "invite not found or expired")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite")
 	}
 
-	if err := tx.Commit(); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit transaction")
+	membershipResponse := func(role string) error {
+		scope, projects, err := h.inviteProjectAccess(ctx, inv)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite projects")
+		}
+		return c.JSON(http.StatusOK, AcceptInviteResponse{
+			OrganizationResponse: OrganizationResponse{
+				ID:        inv.Edges.Organization.ID,
+				Name:      inv.Edges.Organization.Name,
+				Slug:      inv.Edges.Organization.Slug,
+				Role:      role,
+				CreatedAt: inv.Edges.Organization.CreatedAt,
+			},
+			ProjectScope: scope,
+			Projects:     projects,
+		})
+	}
+	findMembership := func() (*ent.OrganizationMember, error) {
+		return h.client.OrganizationMember.Query().
+			Where(
+				organizationmember.UserIDEQ(userID),
+				organizationmember.OrganizationIDEQ(inv.OrganizationID),
+			).
+			Only(ctx)
 	}
 
-	return c.JSON(http.StatusOK, OrganizationResponse{
-		ID:        inv.Edges.Organization.ID,
-		Name:      inv.Edges.Organization.Name,
-		Slug:      inv.Edges.Organization.Slug,
-		Role:      string(role),
-		CreatedAt: inv.Edges.Organization.CreatedAt,
+	// If the user is already a member (e.g. the accept button was clicked twice),
+	// settle the invite and return the existing membership instead of failing
+	membership, err := findMembership()
+	if err == nil {
+		if inv.UsedAt == nil {
+			// A member invited to a project still gains access to it, unless the invite expired
+			err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+				n, err := tx.Invite.Update().
+					Where(invite.IDEQ(inv.ID), invite.UsedAtIsNil()).
+					SetUsedAt(time.Now()).
+					Save(ctx)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "failed to update invite").SetInternal(err)
+				}
+				if n == 0 || !inv.ExpiresAt.After(time.Now()) {
+					return nil
+				}
+				return grantInviteProject(ctx, tx, inv, userID)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return membershipResponse(string(membership.Role))
+	}
+	if !ent.IsNotFound(err) {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	}
+
+	if inv.UsedAt != nil || !inv.ExpiresAt.After(time.Now()) {
+		return echo.NewHTTPError(http.StatusNotFound, "invite not found or expired")
+	}
+
+	// Add user as member
+	role := organizationmember.RoleMember
+	if inv.Role == invite.RoleAdmin {
+		role = organizationmember.RoleAdmin
+	}
+
+	// Transaction: add member, grant the invite's project and mark invite as used
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// Mark invite as used, guarding against another user consuming it concurrently
+		n, err := tx.Invite.Update().
+			Where(invite.IDEQ(inv.ID), invite.UsedAtIsNil()).
+			SetUsedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update invite").SetInternal(err)
+		}
+		if n == 0 {
+			return errInviteAlreadyUsed
+		}
+
+		free, err := freeSeats(ctx, tx.Client(), inv.Edges.Organization, false)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to count members").SetInternal(err)
+		}
+		if free == 0 {
+			return echo.NewHTTPError(http.StatusConflict, "organization has reached its member limit")
+		}
+
+		_, err = tx.OrganizationMember.Create().
+			SetUserID(userID).
+			SetOrganizationID(inv.OrganizationID).
+			SetRole(role).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to add member").SetInternal(err)
+		}
+
+		if err := grantInviteProject(ctx, tx, inv, userID); err != nil {
+			return err
+		}
+
+		// Update user's last accessed org
+		_, err = tx.User.UpdateOneID(userID).
+			SetLastOrgID(inv.OrganizationID).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context").SetInternal(err)
+		}
+		return nil
+	})
+	if errors.Is(err, errInviteAlreadyUsed) {
+		// A concurrent accept by the same user is not a conflict
+		if membership, err := findMembership(); err == nil {
+			return membershipResponse(string(membership.Role))
+		}
+		return echo.NewHTTPError(http.StatusConflict, "this invite has already been used")
+	}
+	if err != nil {
+		return err
+	}
+
+	// The invitee joins on their own, so they are both actor and target
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      inv.OrganizationID,
+		ActorID:    userID,
+		Action:     ActivityMemberAdded,
+		TargetType: ActivityTargetUser,
+		TargetID:   userID,
+		Metadata: map[string]any{
+			"role":          string(role),
+			"invited_by_id": inv.InvitedByID,
+		},
+	})
+	notify(ctx, h.client, notificationEntry{
+		UserID:  inv.InvitedByID,
+		ActorID: userID,
+		Type:    NotificationInviteAccepted,
+		Payload: map[string]any{
+			"organization_id":   inv.OrganizationID,
+			"organization_name": inv.Edges.Organization.Name,
+			"organization_slug": inv.Edges.Organization.Slug,
+			"role":              string(role),
+		},
 	})
+
+	return membershipResponse(string(role))
+}
+
+// grantInviteProject adds the user accepting a project-scoped invite to its project with the
+// invite's permission. A project deleted since the invite was sent is skipped.
+func grantInviteProject(ctx context.Context, tx *ent.Tx, inv *ent.Invite, userID uuid.UUID) error {
+	if inv.ProjectID == nil {
+		return nil
+	}
+	exists, err := tx.Project.Query().
+		Where(
+			project.IDEQ(*inv.ProjectID),
+			project.OrganizationIDEQ(inv.OrganizationID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite project").SetInternal(err)
+	}
+	if !exists {
+		return nil
+	}
+	permission := projectmember.Permission(inviteProjectPermission(inv))
+	if err := grantProjectAccess(ctx, tx, *inv.ProjectID, userID, permission); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to add project member").SetInternal(err)
+	}
+	return nil
 }
 
 // GetInviteInfo gets public info about an invite (for showing before login)
@@ -461,11 +1886,100 @@ func (h *OrganizationHandler) GetInviteInfo(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite")
 	}
 
+	scope, projects, err := h.inviteProjectAccess(ctx, inv)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get invite projects")
+	}
+
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"organization_name": inv.Edges.Organization.Name,
 		"organization_slug": inv.Edges.Organization.Slug,
 		"email":             inv.Email,
 		"expires_at":        inv.ExpiresAt,
+		"project_scope":     scope,
+		"projects":          projects,
 	})
 }
 
+// Invite project scopes
+const (
+	// InviteScopeOrganization means the invitee will see all public projects of the organization
+	InviteScopeOrganization = "organization"
+	// InviteScopeProject means the invite grants access to a single project
+	InviteScopeProject = "project"
+)
+
+// InviteProjectAccess describes a project an invitee gains access to.
+// Only the name is exposed so that private project details don't leak through invite links.
+type InviteProjectAccess struct {
+	Name       string `json:"name"`
+	IsPrivate  bool   `json:"is_private"`
+	Permission string `json:"permission"`
+}
+
+// inviteProjectAccess resolves which projects an invite grants access to and with what permission.
+// Admins can edit every project; private projects are still left out of organization-level
+// invites, since the invite info is shown before the invitee signs in.
+func (h *OrganizationHandler) inviteProjectAccess(ctx context.Context, inv *ent.Invite) (string, []InviteProjectAccess, error) {
+	projects := []InviteProjectAccess{}
+	isAdmin := inv.Role == invite.RoleAdmin
+
+	if inv.ProjectID != nil {
+		proj, err := h.client.Project.Query().
+			Where(
+				project.IDEQ(*inv.ProjectID),
+				project.OrganizationIDEQ(inv.OrganizationID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return InviteScopeProject, projects, nil
+			}
+			return "", nil, err
+		}
+
+		permission := inviteProjectPermission(inv)
+		if isAdmin {
+			permission = string(projectmember.PermissionEdit)
+		}
+		projects = append(projects, InviteProjectAccess{
+			Name:       proj.Name,
+			IsPrivate:  proj.IsPrivate,
+			Permission: permission,
+		})
+		return InviteScopeProject, projects, nil
+	}
+
+	// Organization-level invites give the organization's default permission on every public
+	// project, or edit for admins
+	org := inv.Edges.Organization
+	if org == nil {
+		var err error
+		if org, err = h.client.Organization.Get(ctx, inv.OrganizationID); err != nil {
+			return "", nil, err
+		}
+	}
+	permission := projectmember.Permission(org.DefaultProjectPermission)
+	if isAdmin {
+		permission = projectmember.PermissionEdit
+	}
+	publicProjects, err := h.client.Project.Query().
+		Where(
+			project.OrganizationIDEQ(inv.OrganizationID),
+			project.IsPrivateEQ(false),
+		).
+		Order(ent.Asc(project.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, p := range publicProjects {
+		projects = append(projects, InviteProjectAccess{
+			Name:       p.Name,
+			IsPrivate:  false,
+			Permission: string(permission),
+		})
+	}
+	return InviteScopeOrganization, projects, nil
+}
+
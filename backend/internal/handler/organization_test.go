@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/invite"
+	"backend/ent/organization"
+	"backend/ent/organizationmember"
+	"backend/internal/service"
+
+	"github.com/google/uuid"
+)
+
+// newTestOrganizationHandler creates an organization handler on client
+func newTestOrganizationHandler(t *testing.T, client *ent.Client) *OrganizationHandler {
+	t.Helper()
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewOrganizationHandler(client, emailService)
+}
+
+// createTestInvite creates an unused invite to org for email with role
+func createTestInvite(t *testing.T, client *ent.Client, org *ent.Organization, inviter *ent.User, email string, role invite.Role) *ent.Invite {
+	t.Helper()
+	inv, err := client.Invite.Create().
+		SetToken(uuid.NewString()).
+		SetEmail(email).
+		SetOrganizationID(org.ID).
+		SetRole(role).
+		SetInvitedByID(inviter.ID).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		Save(context.Background())
+	if err != nil {
+		t.Fatalf("creating invite: %v", err)
+	}
+	return inv
+}
+
+func TestInviteInfoProjectPermission(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := newTestOrganizationHandler(t, client)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	createTestProject(t, client, org, "Public", false)
+	createTestProject(t, client, org, "Private", true)
+
+	tests := []struct {
+		defaultPermission organization.DefaultProjectPermission
+		role              invite.Role
+		want              string
+	}{
+		{organization.DefaultProjectPermissionView, invite.RoleMember, "view"},
+		{organization.DefaultProjectPermissionEdit, invite.RoleMember, "edit"},
+		{organization.DefaultProjectPermissionView, invite.RoleAdmin, "edit"},
+	}
+	for _, tt := range tests {
+		if err := org.Update().SetDefaultProjectPermission(tt.defaultPermission).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+		inv := createTestInvite(t, client, org, owner, "invitee@example.com", tt.role)
+
+		c, rec := newTestContext(t, testRequest{Params: map[string]string{"token": inv.Token}})
+		if status := statusOf(t, h.GetInviteInfo(c), rec); status != http.StatusOK {
+			t.Fatalf("status %d, want %d", status, http.StatusOK)
+		}
+		var info struct {
+			ProjectScope string                `json:"project_scope"`
+			Projects     []InviteProjectAccess `json:"projects"`
+		}
+		decodeResponse(t, rec, &info)
+		if info.ProjectScope != InviteScopeOrganization || len(info.Projects) != 1 || info.Projects[0].Name != "Public" {
+			t.Fatalf("default %s, role %s: got scope %q with projects %+v, want the public project only", tt.defaultPermission, tt.role, info.ProjectScope, info.Projects)
+		}
+		if got := info.Projects[0].Permission; got != tt.want {
+			t.Errorf("default %s, role %s: permission %q, want %q", tt.defaultPermission, tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestAcceptInviteUpToMemberLimit(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := newTestOrganizationHandler(t, client)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	first := createTestUser(t, client, "first@example.com")
+	second := createTestUser(t, client, "second@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+
+	// Both invites went out before the limit was lowered to one more member
+	firstInvite := createTestInvite(t, client, org, owner, first.Email, invite.RoleMember)
+	secondInvite := createTestInvite(t, client, org, owner, second.Email, invite.RoleMember)
+	org, err := org.Update().SetMaxMembers(2).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accept := func(u *ent.User, inv *ent.Invite) int {
+		t.Helper()
+		c, rec := newTestContext(t, testRequest{
+			Method: http.MethodPost,
+			Params: map[string]string{"token": inv.Token},
+			UserID: u.ID,
+		})
+		return statusOf(t, h.AcceptInvite(c), rec)
+	}
+
+	if status := accept(first, firstInvite); status != http.StatusOK {
+		t.Fatalf("accept filling the last seat: status %d, want %d", status, http.StatusOK)
+	}
+	if status := accept(second, secondInvite); status != http.StatusConflict {
+		t.Errorf("accept over the limit: status %d, want %d", status, http.StatusConflict)
+	}
+
+	members, err := client.OrganizationMember.Query().
+		Where(organizationmember.OrganizationIDEQ(org.ID)).
+		Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if members != 2 {
+		t.Errorf("%d members, want 2", members)
+	}
+	// The refused invite is still pending, to be accepted once a seat frees up
+	if inv := client.Invite.GetX(ctx, secondInvite.ID); inv.UsedAt != nil {
+		t.Errorf("refused invite was used up")
+	}
+
+	if _, err := client.OrganizationMember.Delete().
+		Where(organizationmember.UserIDEQ(first.ID)).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if status := accept(second, secondInvite); status != http.StatusOK {
+		t.Errorf("accept after a seat freed up: status %d, want %d", status, http.StatusOK)
+	}
+}
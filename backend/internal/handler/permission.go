@@ -1,6 +1,14 @@
 package handler
 
-import "backend/ent/organizationmember"
+import (
+	"net/http"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/internal/auth"
+
+	"github.com/labstack/echo/v4"
+)
 
 // HasAdminPermission checks if the role has admin-level permission (owner or admin)
 func HasAdminPermission(role organizationmember.Role) bool {
@@ -12,3 +20,20 @@ func IsOwner(role organizationmember.Role) bool {
 	return role == organizationmember.RoleOwner
 }
 
+// refuseAPIKey rejects requests authenticated with an API key. A key acts as the member who
+// created it, usually an owner or admin, so owner- and admin-only actions check this first:
+// they are reserved for that member signed in as themselves.
+func refuseAPIKey(c echo.Context) error {
+	if _, ok := auth.GetAPIKey(c); ok {
+		return echo.NewHTTPError(http.StatusForbidden, "api keys cannot be used for owner and admin actions")
+	}
+	return nil
+}
+
+// requireWriteAccess rejects write attempts from members an owner has made read-only
+func requireWriteAccess(membership *ent.OrganizationMember) error {
+	if membership.ReadOnly {
+		return echo.NewHTTPError(http.StatusForbidden, "your access to this organization is read-only")
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+
+	"backend/ent"
+	"backend/ent/project"
+	"backend/ent/schema"
+	"backend/ent/task"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// positionGap is the spacing between items when they are appended or rebalanced
+	positionGap = 1024.0
+	// minPositionSpacing is the smallest gap we split before rebalancing a list
+	minPositionSpacing = 1e-6
+)
+
+// positionBetween returns the midpoint between two neighbouring positions, where a nil
+// neighbour means the start or end of the list. ok is false when the neighbours are too
+// close to split and the list needs rebalancing.
+func positionBetween(prev, next *float64) (pos float64, ok bool) {
+	switch {
+	case prev == nil && next == nil:
+		return positionGap, true
+	case prev == nil:
+		return *next - positionGap, true
+	case next == nil:
+		return *prev + positionGap, true
+	}
+	if *next-*prev < minPositionSpacing {
+		return 0, false
+	}
+	return (*prev + *next) / 2, true
+}
+
+// nextProjectPosition returns the position for a project appended to the end of the organization's list
+func nextProjectPosition(ctx context.Context, projects *ent.ProjectClient, orgID uuid.UUID) (float64, error) {
+	last, err := projects.Query().
+		Where(
+			project.OrganizationIDEQ(orgID),
+			project.PositionNotNil(),
+		).
+		Order(ent.Desc(project.FieldPosition)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return positionGap, nil
+		}
+		return 0, err
+	}
+	return *last.Position + positionGap, nil
+}
+
+// BackfillProjectPositions assigns positions to projects created before manual ordering existed,
+// following their creation order within each organization. It is safe to run on every startup.
+func BackfillProjectPositions(ctx context.Context, client *ent.Client) error {
+	projects, err := client.Project.Query().
+		Where(project.PositionIsNil()).
+		Order(ent.Asc(project.FieldOrganizationID, project.FieldCreatedAt, project.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := make(map[uuid.UUID]float64)
+	for _, p := range projects {
+		pos, ok := next[p.OrganizationID]
+		if !ok {
+			pos, err = nextProjectPosition(ctx, client.Project, p.OrganizationID)
+			if err != nil {
+				return err
+			}
+		}
+		if err := client.Project.UpdateOne(p).SetPosition(pos).Exec(ctx); err != nil {
+			return err
+		}
+		next[p.OrganizationID] = pos + positionGap
+	}
+	return nil
+}
+
+// nextTaskPosition returns the position for a task appended to the end of its status column
+func nextTaskPosition(ctx context.Context, tasks *ent.TaskClient, projectID uuid.UUID, status task.Status) (float64, error) {
+	last, err := tasks.Query().
+		Where(
+			task.ProjectIDEQ(projectID),
+			task.StatusEQ(status),
+			task.PositionNotNil(),
+		).
+		Order(ent.Desc(task.FieldPosition)).
+		First(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return positionGap, nil
+		}
+		return 0, err
+	}
+	return *last.Position + positionGap, nil
+}
+
+// taskColumn identifies a status column of a project's board
+type taskColumn struct {
+	projectID uuid.UUID
+	status    task.Status
+}
+
+// BackfillTaskPositions assigns positions to tasks created before manual ordering existed,
+// following their creation order within each status column. It is safe to run on every startup.
+func BackfillTaskPositions(ctx context.Context, client *ent.Client) error {
+	ctx = schema.SkipSoftDelete(ctx)
+	tasks, err := client.Task.Query().
+		Where(task.PositionIsNil()).
+		Order(ent.Asc(task.FieldProjectID, task.FieldStatus, task.FieldCreatedAt, task.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := make(map[taskColumn]float64)
+	for _, t := range tasks {
+		col := taskColumn{t.ProjectID, t.Status}
+		pos, ok := next[col]
+		if !ok {
+			pos, err = nextTaskPosition(ctx, client.Task, t.ProjectID, t.Status)
+			if err != nil {
+				return err
+			}
+		}
+		if err := client.Task.UpdateOne(t).SetPosition(pos).Exec(ctx); err != nil {
+			return err
+		}
+		next[col] = pos + positionGap
+	}
+	return nil
+}
@@ -1,20 +1,29 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"time"
 
 	"backend/ent"
+	"backend/ent/invite"
+	"backend/ent/label"
 	"backend/ent/organization"
 	"backend/ent/organizationmember"
 	"backend/ent/project"
 	"backend/ent/projectmember"
+	"backend/ent/schema"
+	"backend/ent/task"
+	"backend/ent/user"
 	"backend/internal/auth"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
+// defaultProjectName is the name of the project every organization starts with
+const defaultProjectName = "全般"
+
 // ProjectHandler handles project-related requests
 type ProjectHandler struct {
 	client *ent.Client
@@ -33,12 +42,33 @@ type CreateProjectRequest struct {
 
 // ProjectResponse represents the project data in responses
 type ProjectResponse struct {
-	ID             uuid.UUID `json:"id"`
-	Name           string    `json:"name"`
-	IsPrivate      bool      `json:"is_private"`
-	OrganizationID uuid.UUID `json:"organization_id"`
-	Permission     string    `json:"permission,omitempty"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             uuid.UUID  `json:"id"`
+	Name           string     `json:"name"`
+	IsPrivate      bool       `json:"is_private"`
+	OrganizationID uuid.UUID  `json:"organization_id"`
+	Permission     string     `json:"permission,omitempty"`
+	CreatedByID    *uuid.UUID `json:"created_by_id"`
+	CreatedBy      *string    `json:"created_by"` // Creator's display name
+	CreatedAt      time.Time  `json:"created_at"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+}
+
+// newProjectResponse builds the response for a project loaded with its created_by edge
+func newProjectResponse(p *ent.Project, permission string) ProjectResponse {
+	resp := ProjectResponse{
+		ID:             p.ID,
+		Name:           p.Name,
+		IsPrivate:      p.IsPrivate,
+		OrganizationID: p.OrganizationID,
+		Permission:     permission,
+		CreatedByID:    p.CreatedByID,
+		CreatedAt:      p.CreatedAt,
+		DeletedAt:      p.DeletedAt,
+	}
+	if u := p.Edges.CreatedBy; u != nil {
+		resp.CreatedBy = &u.DisplayName
+	}
+	return resp
 }
 
 // ProjectMemberResponse represents a project member in responses
@@ -99,60 +129,102 @@ func (h *ProjectHandler) CreateProject(c echo.Context) error {
 	}
 
 	// Only owners and admins can create projects
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
 	if !HasAdminPermission(membership.Role) {
 		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can create projects")
 	}
 
 	// Create project in a transaction
-	tx, err := h.client.Tx(ctx)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to start transaction")
-	}
-
-	proj, err := tx.Project.Create().
-		SetName(req.Name).
-		SetOrganizationID(org.ID).
-		SetIsPrivate(req.IsPrivate).
-		Save(ctx)
-	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create project")
-	}
+	var proj *ent.Project
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		position, err := nextProjectPosition(ctx, tx.Project, org.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to determine project position").SetInternal(err)
+		}
 
-	// Add creator as edit member if private
-	if req.IsPrivate {
-		_, err = tx.ProjectMember.Create().
-			SetUserID(userID).
-			SetProjectID(proj.ID).
-			SetPermission(projectmember.PermissionEdit).
+		proj, err = tx.Project.Create().
+			SetName(req.Name).
+			SetOrganizationID(org.ID).
+			SetIsPrivate(req.IsPrivate).
+			SetPosition(position).
+			SetCreatedByID(userID).
 			Save(ctx)
 		if err != nil {
-			_ = tx.Rollback()
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to add project member")
+			if ent.IsConstraintError(err) {
+				return echo.NewHTTPError(http.StatusConflict, "a project with this name already exists")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to create project").SetInternal(err)
 		}
-	}
 
-	// Update user's last accessed project
-	_, err = tx.User.UpdateOneID(userID).
-		SetLastProjectID(proj.ID).
-		Save(ctx)
+		// Add creator as edit member if private
+		if req.IsPrivate {
+			_, err = tx.ProjectMember.Create().
+				SetUserID(userID).
+				SetProjectID(proj.ID).
+				SetPermission(projectmember.PermissionEdit).
+				Save(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to add project member").SetInternal(err)
+			}
+		}
+
+		// Update user's last accessed project
+		proj.Edges.CreatedBy, err = tx.User.UpdateOneID(userID).
+			SetLastProjectID(proj.ID).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context").SetInternal(err)
+		}
+		return nil
+	})
 	if err != nil {
-		_ = tx.Rollback()
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update user context")
+		return err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit transaction")
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      org.ID,
+		ActorID:    userID,
+		Action:     ActivityProjectCreated,
+		TargetType: ActivityTargetProject,
+		TargetID:   proj.ID,
+		Metadata:   projectActivityMetadata(proj),
+	})
+
+	return c.JSON(http.StatusCreated, newProjectResponse(proj, "edit"))
+}
+
+// projectSortFields are the sort keys accepted by ListProjects
+var projectSortFields = SortFields{
+	"position":   {Column: project.FieldPosition, Null: "infinity"},
+	"name":       {Column: project.FieldName},
+	"created_at": {Column: project.FieldCreatedAt},
+}
+
+// projectSortValue returns a project's value for a sort key, for building page cursors
+func projectSortValue(key string, p *ent.Project) any {
+	switch key {
+	case "position":
+		if p.Position == nil {
+			return projectSortFields.nullSortValue(key)
+		}
+		return *p.Position
+	case "name":
+		return p.Name
+	default:
+		return p.CreatedAt
 	}
+}
 
-	return c.JSON(http.StatusCreated, ProjectResponse{
-		ID:             proj.ID,
-		Name:           proj.Name,
-		IsPrivate:      proj.IsPrivate,
-		OrganizationID: proj.OrganizationID,
-		Permission:     "edit",
-		CreatedAt:      proj.CreatedAt,
-	})
+// ProjectListResponse represents a page of an organization's projects
+type ProjectListResponse struct {
+	Projects   []ProjectResponse `json:"projects"`
+	NextCursor *string           `json:"next_cursor"`
 }
 
 // ListProjects lists all projects in an organization
@@ -162,90 +234,180 @@ func (h *ProjectHandler) ListProjects(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	slug := c.Param("slug")
-	if slug == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "organization slug is required")
+	spec, err := parseSort(c, projectSortFields, "position")
+	if err != nil {
+		return err
+	}
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+	after, err := parseCursor(c, spec)
+	if err != nil {
+		return err
 	}
 
 	ctx := c.Request().Context()
 
-	// Get organization
-	org, err := h.client.Organization.Query().
-		Where(organization.SlugEQ(slug)).
-		Only(ctx)
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+		return err
+	}
+	queryCtx, err := includeDeletedContext(c, ctx, membership)
+	if err != nil {
+		return err
 	}
 
-	// Check membership
-	_, err = h.client.OrganizationMember.Query().
+	// Public projects plus the private projects the user is a member of, or all for owners and admins
+	query := h.client.Project.Query().
 		Where(
-			organizationmember.UserIDEQ(userID),
-			organizationmember.OrganizationIDEQ(org.ID),
-		).
-		Only(ctx)
+			project.OrganizationIDEQ(org.ID),
+			visibleProject(userID),
+		)
+	if after != nil {
+		query.Where(after)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	projects, err := query.
+		WithCreatedBy().
+		Order(spec.Order).
+		Limit(limit + 1).
+		All(queryCtx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list projects")
+	}
+
+	var nextCursor *string
+	if len(projects) > limit {
+		projects = projects[:limit]
+		last := projects[len(projects)-1]
+		nextCursor = encodeCursor(spec, []any{projectSortValue(spec.Key, last)}, last.ID)
+	}
+
+	projectIDs := make([]uuid.UUID, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.ID
+	}
+
+	// Get user's explicit permissions for the projects on this page
+	explicit, err := explicitProjectPermissions(ctx, h.client, userID, projectIDs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project memberships")
+	}
+
+	result := make([]ProjectResponse, len(projects))
+	for i, p := range projects {
+		perm, _ := projectPermission(org, membership, p, explicit[p.ID])
+		result[i] = newProjectResponse(p, string(perm))
+	}
+
+	return c.JSON(http.StatusOK, ProjectListResponse{
+		Projects:   result,
+		NextCursor: nextCursor,
+	})
+}
+
+// OrganizationProjectsResponse groups accessible projects under their organization
+type OrganizationProjectsResponse struct {
+	Organization OrganizationResponse `json:"organization"`
+	Projects     []ProjectResponse    `json:"projects"`
+}
+
+// AllProjectsResponse represents a page of projects across all of the user's organizations
+type AllProjectsResponse struct {
+	Organizations []OrganizationProjectsResponse `json:"organizations"`
+	HasMore       bool                           `json:"has_more"`
+}
+
+// ListAllProjects lists the projects the user can access across every organization they belong to,
+// grouped by organization. Results are paginated with ?limit= and ?offset= over projects.
+func (h *ProjectHandler) ListAllProjects(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	// Get all projects in the organization
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+	offset, err := parseOffset(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	// Projects the user can see in the orgs they belong to, resolved in a single query from the
+	// memberships
 	projects, err := h.client.Project.Query().
-		Where(project.OrganizationIDEQ(org.ID)).
-		Order(ent.Asc(project.FieldCreatedAt)).
+		Where(
+			project.HasOrganizationWith(
+				organization.HasOrganizationMembershipsWith(organizationmember.UserIDEQ(userID)),
+			),
+			visibleProject(userID),
+		).
+		WithOrganization().
+		WithCreatedBy().
+		Order(ent.Asc(project.FieldOrganizationID, project.FieldCreatedAt, project.FieldID)).
+		Offset(offset).
+		Limit(limit + 1).
 		All(ctx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list projects")
 	}
 
-	// Get user's project memberships for private projects
-	projectMemberships, err := h.client.ProjectMember.Query().
-		Where(projectmember.UserIDEQ(userID)).
-		All(ctx)
+	hasMore := len(projects) > limit
+	if hasMore {
+		projects = projects[:limit]
+	}
+
+	projectIDs := make([]uuid.UUID, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.ID
+	}
+
+	// Explicit project permissions for the returned projects only
+	explicit, err := explicitProjectPermissions(ctx, h.client, userID, projectIDs)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project memberships")
 	}
 
-	membershipMap := make(map[uuid.UUID]string)
-	for _, pm := range projectMemberships {
-		membershipMap[pm.ProjectID] = string(pm.Permission)
+	orgMemberships, err := h.client.OrganizationMember.Query().
+		Where(organizationmember.UserIDEQ(userID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get memberships")
+	}
+	membershipMap := make(map[uuid.UUID]*ent.OrganizationMember, len(orgMemberships))
+	for _, m := range orgMemberships {
+		membershipMap[m.OrganizationID] = m
 	}
 
-	// Filter and build response
-	var result []ProjectResponse
+	// Projects are ordered by organization, so groups are contiguous
+	result := AllProjectsResponse{
+		Organizations: []OrganizationProjectsResponse{},
+		HasMore:       hasMore,
+	}
 	for _, p := range projects {
-		if p.IsPrivate {
-			// Only include if user is a member
-			if perm, ok := membershipMap[p.ID]; ok {
-				result = append(result, ProjectResponse{
-					ID:             p.ID,
-					Name:           p.Name,
-					IsPrivate:      p.IsPrivate,
-					OrganizationID: p.OrganizationID,
-					Permission:     perm,
-					CreatedAt:      p.CreatedAt,
-				})
-			}
-		} else {
-			// Public project - include for all org members
-			perm := "view"
-			if mp, ok := membershipMap[p.ID]; ok {
-				perm = mp
-			}
-			result = append(result, ProjectResponse{
-				ID:             p.ID,
-				Name:           p.Name,
-				IsPrivate:      p.IsPrivate,
-				OrganizationID: p.OrganizationID,
-				Permission:     perm,
-				CreatedAt:      p.CreatedAt,
+		n := len(result.Organizations)
+		if n == 0 || result.Organizations[n-1].Organization.ID != p.OrganizationID {
+			org := p.Edges.Organization
+			result.Organizations = append(result.Organizations, OrganizationProjectsResponse{
+				Organization: OrganizationResponse{
+					ID:        org.ID,
+					Name:      org.Name,
+					Slug:      org.Slug,
+					Role:      string(membershipMap[org.ID].Role),
+					CreatedAt: org.CreatedAt,
+				},
 			})
+			n++
 		}
+
+		perm, _ := projectPermission(p.Edges.Organization, membershipMap[p.OrganizationID], p, explicit[p.ID])
+		result.Organizations[n-1].Projects = append(result.Organizations[n-1].Projects, newProjectResponse(p, string(perm)))
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -283,7 +445,7 @@ func (h *ProjectHandler) GetProject(c echo.Context) error {
 	}
 
 	// Check org membership
-	_, err = h.client.OrganizationMember.Query().
+	membership, err := h.client.OrganizationMember.Query().
 		Where(
 			organizationmember.UserIDEQ(userID),
 			organizationmember.OrganizationIDEQ(org.ID),
@@ -302,6 +464,7 @@ func (h *ProjectHandler) GetProject(c echo.Context) error {
 			project.IDEQ(projectID),
 			project.OrganizationIDEQ(org.ID),
 		).
+		WithCreatedBy().
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -310,33 +473,12 @@ func (h *ProjectHandler) GetProject(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project")
 	}
 
-	// Check project access for private projects
-	permission := "view"
-	if proj.IsPrivate {
-		pm, err := h.client.ProjectMember.Query().
-			Where(
-				projectmember.UserIDEQ(userID),
-				projectmember.ProjectIDEQ(proj.ID),
-			).
-			Only(ctx)
-		if err != nil {
-			if ent.IsNotFound(err) {
-				return echo.NewHTTPError(http.StatusForbidden, "you do not have access to this project")
-			}
-			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check project membership")
-		}
-		permission = string(pm.Permission)
-	} else {
-		// Check if user has explicit membership for higher permission
-		pm, err := h.client.ProjectMember.Query().
-			Where(
-				projectmember.UserIDEQ(userID),
-				projectmember.ProjectIDEQ(proj.ID),
-			).
-			Only(ctx)
-		if err == nil {
-			permission = string(pm.Permission)
-		}
+	permission, hasAccess, err := EffectiveProjectPermission(ctx, h.client, org, membership, proj)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check project membership")
+	}
+	if !hasAccess {
+		return errNoProjectAccess()
 	}
 
 	// Update user's last accessed project
@@ -345,14 +487,7 @@ func (h *ProjectHandler) GetProject(c echo.Context) error {
 		SetLastOrgID(org.ID).
 		Save(ctx)
 
-	return c.JSON(http.StatusOK, ProjectResponse{
-		ID:             proj.ID,
-		Name:           proj.Name,
-		IsPrivate:      proj.IsPrivate,
-		OrganizationID: proj.OrganizationID,
-		Permission:     permission,
-		CreatedAt:      proj.CreatedAt,
-	})
+	return c.JSON(http.StatusOK, newProjectResponse(proj, permission))
 }
 
 // AddProjectMember adds a member to a project
@@ -413,6 +548,13 @@ func (h *ProjectHandler) AddProjectMember(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
 	}
 
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
 	if !HasAdminPermission(membership.Role) {
 		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can manage project members")
 	}
@@ -485,3 +627,601 @@ func (h *ProjectHandler) AddProjectMember(c echo.Context) error {
 	})
 }
 
+// ReorderProjectRequest represents the request to move a project in the organization's list.
+// A null after_project_id moves the project to the top.
+type ReorderProjectRequest struct {
+	AfterProjectID *string `json:"after_project_id"`
+}
+
+// ReorderProject moves a project directly after another project (owner/admin only)
+func (h *ProjectHandler) ReorderProject(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	slug := c.Param("slug")
+	projectIDStr := c.Param("project_id")
+	if slug == "" || projectIDStr == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "organization slug and project_id are required")
+	}
+
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid project_id format")
+	}
+
+	var req ReorderProjectRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	var afterID *uuid.UUID
+	if req.AfterProjectID != nil {
+		id, err := uuid.Parse(*req.AfterProjectID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid after_project_id format")
+		}
+		if id == projectID {
+			return echo.NewHTTPError(http.StatusBadRequest, "a project cannot be placed after itself")
+		}
+		afterID = &id
+	}
+
+	ctx := c.Request().Context()
+
+	// Get organization
+	org, err := h.client.Organization.Query().
+		Where(organization.SlugEQ(slug)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "organization not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get organization")
+	}
+
+	// Check if requester is org owner/admin
+	membership, err := h.client.OrganizationMember.Query().
+		Where(
+			organizationmember.UserIDEQ(userID),
+			organizationmember.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusForbidden, "you are not a member of this organization")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+	}
+
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can reorder projects")
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// Load the organization's other projects in their current order
+		siblings, err := tx.Project.Query().
+			Where(
+				project.OrganizationIDEQ(org.ID),
+				project.IDNEQ(projectID),
+			).
+			Order(ent.Asc(project.FieldPosition, project.FieldCreatedAt, project.FieldID)).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to list projects").SetInternal(err)
+		}
+
+		exists, err := tx.Project.Query().
+			Where(
+				project.IDEQ(projectID),
+				project.OrganizationIDEQ(org.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project").SetInternal(err)
+		}
+		if !exists {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+
+		// Find the insertion index: right after after_project_id, or at the top
+		index := 0
+		if afterID != nil {
+			index = -1
+			for i, p := range siblings {
+				if p.ID == *afterID {
+					index = i + 1
+					break
+				}
+			}
+			if index == -1 {
+				return echo.NewHTTPError(http.StatusBadRequest, "after_project_id is not a project in this organization")
+			}
+		}
+
+		var prev, next *float64
+		if index > 0 {
+			prev = siblings[index-1].Position
+		}
+		if index < len(siblings) {
+			next = siblings[index].Position
+		}
+
+		position, ok := positionBetween(prev, next)
+		if !ok {
+			// Neighbours are too close to split: respace the whole list, leaving a slot for the project
+			for i, p := range siblings {
+				slot := i + 1
+				if i >= index {
+					slot++
+				}
+				if err := tx.Project.UpdateOne(p).SetPosition(float64(slot) * positionGap).Exec(ctx); err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "failed to reorder projects").SetInternal(err)
+				}
+			}
+			position = float64(index+1) * positionGap
+		}
+
+		if err := tx.Project.UpdateOneID(projectID).SetPosition(position).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to reorder project").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UpdateProjectMemberRequest represents the request to change a project member's permission
+type UpdateProjectMemberRequest struct {
+	Permission string `json:"permission" validate:"required,oneof=edit view"`
+}
+
+// loadProjectMemberForAdmin resolves the project and a member of it for the member management
+// endpoints, which are restricted to org owners and admins
+func (h *ProjectHandler) loadProjectMemberForAdmin(c echo.Context) (*projectAccess, *ent.ProjectMember, error) {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	targetUserID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "invalid user_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := requireWriteAccess(access.Membership); err != nil {
+		return nil, nil, err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return nil, nil, err
+	}
+	if !HasAdminPermission(access.Membership.Role) {
+		return nil, nil, echo.NewHTTPError(http.StatusForbidden, "only owners and admins can manage project members")
+	}
+
+	pm, err := h.client.ProjectMember.Query().
+		Where(
+			projectmember.UserIDEQ(targetUserID),
+			projectmember.ProjectIDEQ(access.Project.ID),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, echo.NewHTTPError(http.StatusNotFound, "project member not found")
+		}
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get project member")
+	}
+
+	return access, pm, nil
+}
+
+// ensureOtherEditorTx fails when pm is the only edit member of a private project, which
+// would leave the project without anyone able to manage its tasks
+func ensureOtherEditorTx(ctx context.Context, tx *ent.Tx, proj *ent.Project, pm *ent.ProjectMember) error {
+	if !proj.IsPrivate || pm.Permission != projectmember.PermissionEdit {
+		return nil
+	}
+
+	others, err := tx.ProjectMember.Query().
+		Where(
+			projectmember.ProjectIDEQ(proj.ID),
+			projectmember.PermissionEQ(projectmember.PermissionEdit),
+			projectmember.IDNEQ(pm.ID),
+		).
+		Count(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to count project editors").SetInternal(err)
+	}
+	if others == 0 {
+		return echo.NewHTTPError(http.StatusConflict, "a private project must keep at least one member with edit permission")
+	}
+	return nil
+}
+
+// UpdateProjectMember changes a project member's permission (owner/admin only)
+func (h *ProjectHandler) UpdateProjectMember(c echo.Context) error {
+	var req UpdateProjectMemberRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	access, pm, err := h.loadProjectMemberForAdmin(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	permission := projectmember.Permission(req.Permission)
+
+	var updated *ent.ProjectMember
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if permission == projectmember.PermissionView {
+			if err := ensureOtherEditorTx(ctx, tx, access.Project, pm); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		updated, err = tx.ProjectMember.UpdateOneID(pm.ID).
+			SetPermission(permission).
+			Save(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update project member").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, ProjectMemberResponse{
+		UserID:      pm.UserID,
+		Email:       pm.Edges.User.Email,
+		DisplayName: pm.Edges.User.DisplayName,
+		Permission:  string(updated.Permission),
+		JoinedAt:    updated.CreatedAt,
+	})
+}
+
+// RemoveProjectMember removes a member from a project (owner/admin only)
+func (h *ProjectHandler) RemoveProjectMember(c echo.Context) error {
+	access, pm, err := h.loadProjectMemberForAdmin(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if err := ensureOtherEditorTx(ctx, tx, access.Project, pm); err != nil {
+			return err
+		}
+		if err := tx.ProjectMember.DeleteOneID(pm.ID).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to remove project member").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// UpdateProjectRequest represents the request to update a project. Omitted fields are left unchanged.
+type UpdateProjectRequest struct {
+	Name      *string `json:"name" validate:"omitempty,min=1"`
+	IsPrivate *bool   `json:"is_private"`
+}
+
+// UpdateProject renames a project or changes its visibility. Renaming needs edit permission;
+// changing visibility needs owner/admin.
+func (h *ProjectHandler) UpdateProject(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req UpdateProjectRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	// Validate request using validator
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	proj := access.Project
+	visibilityChanged := req.IsPrivate != nil && *req.IsPrivate != proj.IsPrivate
+	if visibilityChanged {
+		if err := refuseAPIKey(c); err != nil {
+			return err
+		}
+		if !HasAdminPermission(access.Membership.Role) {
+			return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can change project visibility")
+		}
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		update := tx.Project.UpdateOneID(proj.ID)
+		if req.Name != nil {
+			update.SetName(*req.Name)
+		}
+		if visibilityChanged {
+			update.SetIsPrivate(*req.IsPrivate)
+		}
+
+		var err error
+		proj, err = update.Save(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				return echo.NewHTTPError(http.StatusConflict, "a project with this name already exists")
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update project").SetInternal(err)
+		}
+
+		// Org members without explicit membership lose access to a project made private,
+		// so make sure the admin making the change keeps edit access to it
+		if visibilityChanged && proj.IsPrivate {
+			pm, err := tx.ProjectMember.Query().
+				Where(
+					projectmember.UserIDEQ(userID),
+					projectmember.ProjectIDEQ(proj.ID),
+				).
+				Only(ctx)
+			switch {
+			case ent.IsNotFound(err):
+				err = tx.ProjectMember.Create().
+					SetUserID(userID).
+					SetProjectID(proj.ID).
+					SetPermission(projectmember.PermissionEdit).
+					Exec(ctx)
+			case err == nil && pm.Permission != projectmember.PermissionEdit:
+				err = pm.Update().
+					SetPermission(projectmember.PermissionEdit).
+					Exec(ctx)
+			}
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to add project member").SetInternal(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The update result carries no edges; projects from before creator tracking have none
+	if proj.CreatedByID != nil {
+		proj.Edges.CreatedBy, _ = proj.QueryCreatedBy().Only(ctx)
+	}
+
+	return c.JSON(http.StatusOK, newProjectResponse(proj, string(access.Permission)))
+}
+
+// DeleteProject deletes a project with its tasks, members and invites (owner/admin only).
+// The organization's default project cannot be deleted.
+func (h *ProjectHandler) DeleteProject(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	if err := requireWriteAccess(access.Membership); err != nil {
+		return err
+	}
+
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(access.Membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can delete projects")
+	}
+
+	if access.Project.IsDefault {
+		return echo.NewHTTPError(http.StatusConflict, "the default project cannot be deleted")
+	}
+
+	// Soft delete: the project and its tasks stay in the database and can be restored
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if _, err := tx.User.Update().
+			Where(user.LastProjectIDEQ(access.Project.ID)).
+			ClearLastProjectID().
+			Save(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to clear user context").SetInternal(err)
+		}
+
+		if err := tx.Project.UpdateOneID(access.Project.ID).
+			SetDeletedAt(time.Now()).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete project").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreProject undoes the deletion of a project (owner/admin only)
+func (h *ProjectHandler) RestoreProject(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	projectID, err := uuid.Parse(c.Param("project_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid project_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+	if err := refuseAPIKey(c); err != nil {
+		return err
+	}
+	if !HasAdminPermission(membership.Role) {
+		return echo.NewHTTPError(http.StatusForbidden, "only owners and admins can restore projects")
+	}
+
+	proj, err := h.client.Project.Query().
+		Where(
+			project.IDEQ(projectID),
+			project.OrganizationIDEQ(org.ID),
+		).
+		Only(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project")
+	}
+	if proj.DeletedAt == nil {
+		return echo.NewHTTPError(http.StatusConflict, "project is not deleted")
+	}
+
+	proj, err = proj.Update().
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		// Another project took the name while this one was deleted
+		if ent.IsConstraintError(err) {
+			return echo.NewHTTPError(http.StatusConflict, "a project with this name already exists")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to restore project")
+	}
+
+	if proj.CreatedByID != nil {
+		proj.Edges.CreatedBy, _ = proj.QueryCreatedBy().Only(ctx)
+	}
+
+	return c.JSON(http.StatusOK, newProjectResponse(proj, string(projectmember.PermissionEdit)))
+}
+
+// deleteProjectsTx permanently deletes projects together with everything that references them:
+// task history, tasks, project members and project-scoped invites. Users whose last
+// accessed project is among them have it cleared.
+func deleteProjectsTx(ctx context.Context, tx *ent.Tx, projectIDs []uuid.UUID) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.User.Update().
+		Where(user.LastProjectIDIn(projectIDs...)).
+		ClearLastProjectID().
+		Save(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to clear user context").SetInternal(err)
+	}
+
+	if err := deleteTasksTx(ctx, tx, task.ProjectIDIn(projectIDs...)); err != nil {
+		return err
+	}
+
+	if _, err := tx.Label.Delete().
+		Where(label.ProjectIDIn(projectIDs...)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete project labels").SetInternal(err)
+	}
+
+	if _, err := tx.ProjectMember.Delete().
+		Where(projectmember.ProjectIDIn(projectIDs...)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete project members").SetInternal(err)
+	}
+
+	if _, err := tx.Invite.Delete().
+		Where(invite.ProjectIDIn(projectIDs...)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete project invites").SetInternal(err)
+	}
+
+	if _, err := tx.Project.Delete().
+		Where(project.IDIn(projectIDs...)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete projects").SetInternal(err)
+	}
+	return nil
+}
+
+// BackfillDefaultProjects marks the default project of organizations created before the
+// is_default flag existed: the oldest project still carrying the default name.
+func BackfillDefaultProjects(ctx context.Context, client *ent.Client) error {
+	orgIDs, err := client.Organization.Query().
+		Where(organization.Not(organization.HasProjectsWith(project.IsDefault(true)))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, orgID := range orgIDs {
+		p, err := client.Project.Query().
+			Where(
+				project.OrganizationIDEQ(orgID),
+				project.NameEQ(defaultProjectName),
+			).
+			Order(ent.Asc(project.FieldCreatedAt, project.FieldID)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if err := p.Update().SetIsDefault(true).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"backend/ent"
+	"backend/ent/organization"
+	"backend/ent/organizationmember"
+	"backend/ent/project"
+	"backend/ent/projectmember"
+	"backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func TestListProjectsIgnoresMembershipsOfOtherOrganizations(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := NewProjectHandler(client)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	acme := createTestOrg(t, client, "acme", owner)
+	other := createTestOrg(t, client, "other", owner)
+	addTestMember(t, client, acme, member, organizationmember.RoleMember)
+	addTestMember(t, client, other, member, organizationmember.RoleMember)
+
+	public := createTestProject(t, client, acme, "Public", false)
+	elsewhere := createTestProject(t, client, other, "Elsewhere", true)
+	if err := client.ProjectMember.Create().
+		SetProjectID(elsewhere.ID).
+		SetUserID(member.ID).
+		SetPermission(projectmember.PermissionEdit).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	explicit, err := explicitProjectPermissions(ctx, client, member.ID, []uuid.UUID{public.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(explicit) != 0 {
+		t.Errorf("explicit permissions %v, want none", explicit)
+	}
+
+	c, rec := newTestContext(t, testRequest{Params: map[string]string{"slug": acme.Slug}, UserID: member.ID})
+	if err := h.ListProjects(c); err != nil {
+		t.Fatal(err)
+	}
+	var resp ProjectListResponse
+	decodeResponse(t, rec, &resp)
+	if len(resp.Projects) != 1 || resp.Projects[0].ID != public.ID {
+		t.Fatalf("projects %+v, want only %s", resp.Projects, public.Name)
+	}
+	if got := resp.Projects[0].Permission; got != string(projectmember.PermissionView) {
+		t.Errorf("permission %q, want the organization default %q", got, projectmember.PermissionView)
+	}
+}
+
+func TestPrivateProjectsOfOtherMembers(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects := NewProjectHandler(client)
+	tasks := NewTaskHandler(client, emailService, nil)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	member := createTestUser(t, client, "member@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	if err := org.Update().SetDefaultProjectPermission(organization.DefaultProjectPermissionEdit).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	addTestMember(t, client, org, member, organizationmember.RoleMember)
+	public := createTestProject(t, client, org, "Public", false)
+	private := createTestProject(t, client, org, "Private", true)
+	task, err := client.Task.Create().
+		SetProjectID(public.ID).
+		SetTitle("Task").
+		SetCreatedByID(owner.ID).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { hidePrivateProjects = false })
+	routes := []struct {
+		name    string
+		handler echo.HandlerFunc
+		method  string
+		project string
+		params  map[string]string
+		body    any
+	}{
+		{"GET project", projects.GetProject, http.MethodGet, private.ID.String(), nil, nil},
+		{"GET project (missing)", projects.GetProject, http.MethodGet, uuid.NewString(), nil, nil},
+		{"PATCH project", projects.UpdateProject, http.MethodPatch, private.ID.String(), nil, map[string]string{"name": "Renamed"}},
+		{"GET tasks", tasks.ListTasks, http.MethodGet, private.ID.String(), nil, nil},
+		{"GET tasks.csv", tasks.ExportTasks, http.MethodGet, private.ID.String(), nil, nil},
+		{"move a task into the project", tasks.MoveTask, http.MethodPost, public.ID.String(),
+			map[string]string{"task_id": task.ID.String()}, MoveTaskRequest{ProjectID: private.ID.String()}},
+	}
+	for _, mode := range []struct {
+		hide bool
+		want int
+	}{
+		{false, http.StatusForbidden},
+		{true, http.StatusNotFound},
+	} {
+		hidePrivateProjects = mode.hide
+		for _, route := range routes {
+			want := mode.want
+			if route.name == "GET project (missing)" {
+				want = http.StatusNotFound
+			}
+			params := map[string]string{"slug": org.Slug, "project_id": route.project}
+			for k, v := range route.params {
+				params[k] = v
+			}
+			c, rec := newTestContext(t, testRequest{Method: route.method, Params: params, Body: route.body, UserID: member.ID})
+			if status := statusOf(t, route.handler(c), rec); status != want {
+				t.Errorf("HIDE_PRIVATE_PROJECTS=%v, %s: status %d, want %d", mode.hide, route.name, status, want)
+			}
+		}
+	}
+}
+
+func TestDuplicateProjectNames(t *testing.T) {
+	client := newTestClient(t)
+	h := NewProjectHandler(client)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	other := createTestOrg(t, client, "other", owner)
+	createTestProject(t, client, org, "Roadmap", false)
+	createTestProject(t, client, other, "Launch", false)
+	launch := createTestProject(t, client, org, "Launch", false)
+
+	call := func(handler echo.HandlerFunc, method, slug, projectID string, body any) int {
+		t.Helper()
+		c, rec := newTestContext(t, testRequest{
+			Method: method,
+			Params: map[string]string{"slug": slug, "project_id": projectID},
+			Body:   body,
+			UserID: owner.ID,
+		})
+		return statusOf(t, handler(c), rec)
+	}
+
+	if status := call(h.CreateProject, http.MethodPost, org.Slug, "", CreateProjectRequest{Name: "Roadmap"}); status != http.StatusConflict {
+		t.Errorf("create with a taken name: status %d, want %d", status, http.StatusConflict)
+	}
+	if status := call(h.UpdateProject, http.MethodPatch, org.Slug, launch.ID.String(), map[string]string{"name": "Roadmap"}); status != http.StatusConflict {
+		t.Errorf("rename to a taken name: status %d, want %d", status, http.StatusConflict)
+	}
+	if status := call(h.CreateProject, http.MethodPost, other.Slug, "", CreateProjectRequest{Name: "Roadmap"}); status != http.StatusCreated {
+		t.Errorf("create with a name taken in another organization: status %d, want %d", status, http.StatusCreated)
+	}
+
+	// A deleted project's name is free again, and restoring the project then conflicts
+	if status := call(h.DeleteProject, http.MethodDelete, org.Slug, launch.ID.String(), nil); status != http.StatusNoContent {
+		t.Fatalf("delete: status %d, want %d", status, http.StatusNoContent)
+	}
+	if status := call(h.CreateProject, http.MethodPost, org.Slug, "", CreateProjectRequest{Name: "Launch"}); status != http.StatusCreated {
+		t.Errorf("create with a deleted project's name: status %d, want %d", status, http.StatusCreated)
+	}
+	if status := call(h.RestoreProject, http.MethodPost, org.Slug, launch.ID.String(), nil); status != http.StatusConflict {
+		t.Errorf("restore over a taken name: status %d, want %d", status, http.StatusConflict)
+	}
+}
+
+func TestReorderProject(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	h := NewProjectHandler(client)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	acme := createTestOrg(t, client, "acme", owner)
+	other := createTestOrg(t, client, "other", owner)
+	a := createTestProject(t, client, acme, "A", false)
+	b := createTestProject(t, client, acme, "B", false)
+	c := createTestProject(t, client, acme, "C", false)
+	elsewhere := createTestProject(t, client, other, "Elsewhere", false)
+	for i, p := range []*ent.Project{a, b, c} {
+		if err := p.Update().SetPosition(float64(i+1) * positionGap).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reorder := func(p *ent.Project, after *ent.Project) int {
+		t.Helper()
+		var req ReorderProjectRequest
+		if after != nil {
+			id := after.ID.String()
+			req.AfterProjectID = &id
+		}
+		ec, rec := newTestContext(t, testRequest{
+			Method: http.MethodPut,
+			Params: map[string]string{"slug": acme.Slug, "project_id": p.ID.String()},
+			Body:   req,
+			UserID: owner.ID,
+		})
+		return statusOf(t, h.ReorderProject(ec), rec)
+	}
+	order := func() []string {
+		t.Helper()
+		projects := client.Project.Query().
+			Where(project.OrganizationIDEQ(acme.ID)).
+			Order(ent.Asc(project.FieldPosition, project.FieldCreatedAt, project.FieldID)).
+			AllX(ctx)
+		names := make([]string, len(projects))
+		for i, p := range projects {
+			names[i] = p.Name
+		}
+		return names
+	}
+
+	steps := []struct {
+		project, after *ent.Project
+		wantStatus     int
+		wantOrder      string
+	}{
+		{c, nil, http.StatusNoContent, "C A B"},
+		{a, b, http.StatusNoContent, "C B A"},
+		{b, c, http.StatusNoContent, "C B A"},
+		{c, a, http.StatusNoContent, "B A C"},
+		// Failed moves leave the order as it was
+		{a, elsewhere, http.StatusBadRequest, "B A C"},
+		{elsewhere, a, http.StatusNotFound, "B A C"},
+	}
+	for _, s := range steps {
+		if status := reorder(s.project, s.after); status != s.wantStatus {
+			t.Fatalf("move %s: status %d, want %d", s.project.Name, status, s.wantStatus)
+		}
+		if got := strings.Join(order(), " "); got != s.wantOrder {
+			t.Fatalf("after moving %s: order %q, want %q", s.project.Name, got, s.wantOrder)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// SortField is a column a list endpoint can be sorted by
+type SortField struct {
+	Column string
+	// Null, when set, is the value NULLs sort as (e.g. "infinity"), so that rows without
+	// a value keep the database's NULLS LAST order and can still be paged through by cursor
+	Null string
+}
+
+// SortFields maps the public sort keys accepted by a list endpoint to the columns they order by
+type SortFields map[string]SortField
+
+// sortSpec is a validated ordering for a list query. Rows are ordered by exprs and then by id,
+// all in the same direction, so that a row's position is fully determined by those values.
+type sortSpec struct {
+	// Sort is the requested ?sort= value, including any "-" prefix
+	Sort string
+	// Key is the sort key without the "-" prefix
+	Key  string
+	desc bool
+	// width is the number of expressions returned by exprs
+	width int
+	exprs func(s *sql.Selector) []string
+}
+
+// parseSort validates the ?sort= query parameter against a whitelist of sort keys and
+// returns the matching ordering. A key may be prefixed with "-" for descending order.
+// The parameter value is only ever used as a map key and never reaches SQL, so unknown
+// keys are rejected with 400 rather than passed through.
+func parseSort(c echo.Context, fields SortFields, defaultKey string) (*sortSpec, error) {
+	key := c.QueryParam("sort")
+	if key == "" {
+		key = defaultKey
+	}
+
+	field, ok := fields[strings.TrimPrefix(key, "-")]
+	if !ok {
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "sort must be one of: "+strings.Join(keys, ", "))
+	}
+
+	return &sortSpec{
+		Sort:  key,
+		Key:   strings.TrimPrefix(key, "-"),
+		desc:  strings.HasPrefix(key, "-"),
+		width: 1,
+		exprs: func(s *sql.Selector) []string {
+			if field.Null != "" {
+				return []string{"COALESCE(" + s.C(field.Column) + ", '" + field.Null + "')"}
+			}
+			return []string{s.C(field.Column)}
+		},
+	}, nil
+}
+
+// nullSortValue returns the value a NULL of the key's column sorts as, for cursors
+func (f SortFields) nullSortValue(key string) any {
+	if field, ok := f[key]; ok && field.Null != "" {
+		return field.Null
+	}
+	return nil
+}
+
+// columns returns the sort expressions followed by the id tie-breaker
+func (o *sortSpec) columns(s *sql.Selector) []string {
+	return append(o.exprs(s), s.C("id"))
+}
+
+// Order applies the ordering to a query
+func (o *sortSpec) Order(s *sql.Selector) {
+	dir := " ASC"
+	if o.desc {
+		dir = " DESC"
+	}
+	for _, col := range o.columns(s) {
+		s.OrderExpr(sql.Expr(col + dir))
+	}
+}
+
+// after returns a predicate matching the rows that come after the cursor in this ordering
+func (o *sortSpec) after(cur *pageCursor) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		op := " > "
+		if o.desc {
+			op = " < "
+		}
+		args := append(append([]any{}, cur.Values...), cur.ID)
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(" + strings.Join(o.columns(s), ", ") + ")" + op + "(")
+			b.Args(args...)
+			b.WriteString(")")
+		}))
+	}
+}
+
+// pageCursor is the position of the last row of a page. It is handed to clients as an opaque string.
+type pageCursor struct {
+	Sort   string    `json:"s"`
+	Values []any     `json:"v"`
+	ID     uuid.UUID `json:"id"`
+}
+
+// parseCursor reads the ?cursor= query parameter and returns a predicate selecting the rows
+// after it, or nil when no cursor was given. A cursor only applies to the ordering it was issued for.
+func parseCursor(c echo.Context, spec *sortSpec) (func(*sql.Selector), error) {
+	raw := c.QueryParam("cursor")
+	if raw == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid cursor")
+	}
+	var cur pageCursor
+	if err := json.Unmarshal(data, &cur); err != nil || cur.ID == uuid.Nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid cursor")
+	}
+	if cur.Sort != spec.Sort || len(cur.Values) != spec.width {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "cursor does not match the requested sort")
+	}
+
+	return spec.after(&cur), nil
+}
+
+// encodeCursor builds the cursor for the page that follows the row with the given sort values and id
+func encodeCursor(spec *sortSpec, values []any, id uuid.UUID) *string {
+	data, err := json.Marshal(pageCursor{Sort: spec.Sort, Values: values, ID: id})
+	if err != nil {
+		return nil
+	}
+	cursor := base64.RawURLEncoding.EncodeToString(data)
+	return &cursor
+}
+
+// parseLimit reads the ?limit= query parameter, applying a default and capping it at max
+func parseLimit(c echo.Context, defaultLimit, max int) (int, error) {
+	raw := c.QueryParam("limit")
+	if raw == "" {
+		return defaultLimit, nil
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "limit must be a positive integer")
+	}
+	if limit > max {
+		limit = max
+	}
+	return limit, nil
+}
+
+// parseOffset reads the ?offset= query parameter
+func parseOffset(c echo.Context) (int, error) {
+	raw := c.QueryParam("offset")
+	if raw == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(raw)
+	if err != nil || offset < 0 {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "offset must be a non-negative integer")
+	}
+	return offset, nil
+}
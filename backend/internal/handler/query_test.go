@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var testSortFields = SortFields{
+	"created_at": {Column: "created_at"},
+	"due_date":   {Column: "due_date", Null: "infinity"},
+}
+
+// sortContext parses sort as a ?sort= parameter, leaving it out when empty
+func sortContext(t *testing.T, sort string) *sortSpec {
+	t.Helper()
+	path := ""
+	if sort != "" {
+		path = "?" + url.Values{"sort": {sort}}.Encode()
+	}
+	c, _ := newTestContext(t, testRequest{Path: path})
+	spec, err := parseSort(c, testSortFields, "-created_at")
+	if err != nil {
+		t.Fatalf("parseSort(%q): %v", sort, err)
+	}
+	return spec
+}
+
+// orderSQL renders the ORDER BY a sort spec applies to a query on tasks
+func orderSQL(spec *sortSpec) string {
+	s := sql.Select("*").From(sql.Table("tasks"))
+	spec.Order(s)
+	query, _ := s.Query()
+	return query[strings.Index(query, "ORDER BY"):]
+}
+
+func TestParseSortAcceptsWhitelistedKeys(t *testing.T) {
+	tests := []struct {
+		sort string
+		key  string
+		want string
+	}{
+		{"", "created_at", "ORDER BY `tasks`.`created_at` DESC, `tasks`.`id` DESC"},
+		{"created_at", "created_at", "ORDER BY `tasks`.`created_at` ASC, `tasks`.`id` ASC"},
+		{"-created_at", "created_at", "ORDER BY `tasks`.`created_at` DESC, `tasks`.`id` DESC"},
+		{"due_date", "due_date", "ORDER BY COALESCE(`tasks`.`due_date`, 'infinity') ASC, `tasks`.`id` ASC"},
+	}
+	for _, tt := range tests {
+		spec := sortContext(t, tt.sort)
+		if spec.Key != tt.key {
+			t.Errorf("sort %q: key %q, want %q", tt.sort, spec.Key, tt.key)
+		}
+		if got := orderSQL(spec); got != tt.want {
+			t.Errorf("sort %q: %s, want %s", tt.sort, got, tt.want)
+		}
+	}
+}
+
+func TestParseSortRejectsUnknownKeys(t *testing.T) {
+	for _, sort := range []string{
+		"title",
+		"--created_at",
+		"created_at DESC",
+		"created_at; DROP TABLE tasks",
+		"(SELECT password_hash FROM users)",
+		"CREATED_AT",
+	} {
+		c, _ := newTestContext(t, testRequest{Path: "?" + url.Values{"sort": {sort}}.Encode()})
+		_, err := parseSort(c, testSortFields, "-created_at")
+		if err == nil {
+			t.Errorf("sort %q: accepted", sort)
+			continue
+		}
+		if status := statusOf(t, err, nil); status != http.StatusBadRequest {
+			t.Errorf("sort %q: status %d, want %d", sort, status, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestParseCursorRejectsCursorOfAnotherSort(t *testing.T) {
+	spec := sortContext(t, "created_at")
+	cursor := encodeCursor(sortContext(t, "due_date"), []any{"2026-01-01"}, [16]byte{1})
+
+	c, _ := newTestContext(t, testRequest{Path: "?" + url.Values{"cursor": {*cursor}}.Encode()})
+	_, err := parseCursor(c, spec)
+	if err == nil {
+		t.Fatal("cursor of another sort accepted")
+	}
+	if status := statusOf(t, err, nil); status != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", status, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"backend/ent"
+	"backend/ent/project"
+	"backend/ent/task"
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// searchSnippetRadius is how many characters of context a snippet keeps around the match
+const searchSnippetRadius = 40
+
+// SearchHandler handles search requests
+type SearchHandler struct {
+	client *ent.Client
+}
+
+// NewSearchHandler creates a new search handler
+func NewSearchHandler(client *ent.Client) *SearchHandler {
+	return &SearchHandler{client: client}
+}
+
+// TaskSearchResult represents a task matching a search
+type TaskSearchResult struct {
+	ID      uuid.UUID `json:"id"`
+	Title   string    `json:"title"`
+	Status  string    `json:"status"`
+	Snippet string    `json:"snippet"`
+}
+
+// ProjectSearchResults groups search matches under their project
+type ProjectSearchResults struct {
+	ProjectID   uuid.UUID          `json:"project_id"`
+	ProjectName string             `json:"project_name"`
+	Tasks       []TaskSearchResult `json:"tasks"`
+}
+
+// SearchResponse represents the results of a task search
+type SearchResponse struct {
+	Query    string                 `json:"query"`
+	Projects []ProjectSearchResults `json:"projects"`
+}
+
+// SearchTasks searches task titles and descriptions in the projects of an organization the user can see
+func (h *SearchHandler) SearchTasks(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	q := strings.TrimSpace(c.QueryParam("q"))
+	if q == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "q is required")
+	}
+
+	limit, err := parseLimit(c, 20, 50)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	org, _, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+
+	tasks, err := h.client.Task.Query().
+		Where(
+			task.HasProjectWith(
+				project.OrganizationIDEQ(org.ID),
+				project.DeletedAtIsNil(), // Edge predicates are not covered by the soft-delete interceptor
+				visibleProject(userID),
+			),
+			task.Or(
+				task.TitleContainsFold(q),
+				task.DescriptionContainsFold(q),
+			),
+		).
+		WithProject().
+		Order(ent.Desc(task.FieldUpdatedAt, task.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to search tasks")
+	}
+
+	// Group by project, keeping projects in the order of their most recent match
+	response := SearchResponse{Query: q, Projects: []ProjectSearchResults{}}
+	index := make(map[uuid.UUID]int)
+	for _, t := range tasks {
+		i, ok := index[t.ProjectID]
+		if !ok {
+			i = len(response.Projects)
+			index[t.ProjectID] = i
+			response.Projects = append(response.Projects, ProjectSearchResults{
+				ProjectID:   t.ProjectID,
+				ProjectName: t.Edges.Project.Name,
+			})
+		}
+		response.Projects[i].Tasks = append(response.Projects[i].Tasks, TaskSearchResult{
+			ID:      t.ID,
+			Title:   t.Title,
+			Status:  string(t.Status),
+			Snippet: searchSnippet(t, q),
+		})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// searchSnippet returns the part of the task's text around the first match of q,
+// preferring the title and falling back to the description
+func searchSnippet(t *ent.Task, q string) string {
+	for _, text := range []string{t.Title, t.Description} {
+		if snippet, ok := snippetAround(text, q); ok {
+			return snippet
+		}
+	}
+	return t.Title
+}
+
+// snippetAround cuts text down to the match of q (case-insensitive) plus some context on both sides
+func snippetAround(text, q string) (string, bool) {
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	needle := []rune(strings.ToLower(q))
+
+	// Lowercasing can change the length of some characters; give up on cutting in that case
+	if len(lower) != len(runes) {
+		return text, strings.Contains(strings.ToLower(text), strings.ToLower(q))
+	}
+
+	at := strings.Index(string(lower), string(needle))
+	if at < 0 {
+		return "", false
+	}
+	start := utf8.RuneCountInString(string(lower)[:at])
+	end := start + len(needle)
+
+	from := max(start-searchSnippetRadius, 0)
+	to := min(end+searchSnippetRadius, len(runes))
+
+	snippet := string(runes[from:to])
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+	return snippet, true
+}
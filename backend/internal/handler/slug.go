@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"backend/ent"
+	"backend/ent/organization"
+)
+
+// maxDerivedSlugLength keeps slugs derived from long names readable in URLs
+const maxDerivedSlugLength = 40
+
+// reservedSlugs are the static path segments under /organizations. An organization with one
+// of these slugs would be shadowed by the static route.
+var reservedSlugs = map[string]bool{
+	"check-slug": true,
+}
+
+// slugFromName turns a name into a slug: lowercase ASCII letters and digits, with every
+// run of other characters collapsed into one hyphen. It returns "" when nothing is left,
+// as for a name written entirely in Japanese.
+func slugFromName(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+			if b.Len() >= maxDerivedSlugLength {
+				break
+			}
+			continue
+		}
+		hyphen = true
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// randomSlug returns a short random slug for names that yield no usable characters
+func randomSlug() (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return "org-" + string(b), nil
+}
+
+// uniqueSlug derives a free slug from an organization name, appending -2, -3, ... when the
+// plain slug is taken or reserved. A concurrent create can still claim it first; the unique index on
+// slug turns that into a constraint error for the caller.
+func uniqueSlug(ctx context.Context, client *ent.Client, name string) (string, error) {
+	base := slugFromName(name)
+	if base == "" {
+		// Random slugs practically never collide, but check a few in case one does
+		for range 3 {
+			slug, err := randomSlug()
+			if err != nil {
+				return "", err
+			}
+			exists, err := client.Organization.Query().Where(organization.SlugEQ(slug)).Exist(ctx)
+			if err != nil {
+				return "", err
+			}
+			if !exists {
+				return slug, nil
+			}
+		}
+		return "", fmt.Errorf("no free random slug found")
+	}
+
+	taken, err := client.Organization.Query().
+		Where(organization.Or(
+			organization.SlugEQ(base),
+			organization.SlugHasPrefix(base+"-"),
+		)).
+		Select(organization.FieldSlug).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+	used := make(map[string]bool, len(taken))
+	for _, s := range taken {
+		used[s] = true
+	}
+	for s := range reservedSlugs {
+		used[s] = true
+	}
+	if !used[base] {
+		return base, nil
+	}
+	for n := 2; ; n++ {
+		if slug := fmt.Sprintf("%s-%d", base, n); !used[slug] {
+			return slug, nil
+		}
+	}
+}
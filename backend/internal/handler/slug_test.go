@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"backend/internal/service"
+)
+
+func TestUniqueSlugSkipsReservedSlugs(t *testing.T) {
+	client := newTestClient(t)
+
+	slug, err := uniqueSlug(context.Background(), client, "Check Slug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slug != "check-slug-2" {
+		t.Errorf("slug %q, want %q", slug, "check-slug-2")
+	}
+}
+
+func TestReservedSlugsAreRejected(t *testing.T) {
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewOrganizationHandler(client, emailService)
+	owner := createTestUser(t, client, "owner@example.com")
+
+	// Checking works without a signed-in user
+	c, rec := newTestContext(t, testRequest{Path: "?slug=check-slug"})
+	if err := h.CheckSlug(c); err != nil {
+		t.Fatal(err)
+	}
+	var availability SlugAvailabilityResponse
+	decodeResponse(t, rec, &availability)
+	if availability.Available || availability.Reason != SlugReserved {
+		t.Errorf("check: %+v, want reason %q", availability, SlugReserved)
+	}
+
+	c, rec = newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Body:   CreateOrganizationRequest{Name: "Check", Slug: "check-slug"},
+		UserID: owner.ID,
+	})
+	if status := statusOf(t, h.CreateOrganization(c), rec); status != http.StatusBadRequest {
+		t.Errorf("create: status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	org := createTestOrg(t, client, "acme", owner)
+	c, rec = newTestContext(t, testRequest{
+		Method: http.MethodPatch,
+		Params: map[string]string{"slug": org.Slug},
+		Body:   json.RawMessage(`{"slug":"check-slug"}`),
+		UserID: owner.ID,
+	})
+	if status := statusOf(t, h.UpdateOrganization(c), rec); status != http.StatusBadRequest {
+		t.Errorf("update: status %d, want %d", status, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,1186 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/attachment"
+	"backend/ent/comment"
+	"backend/ent/label"
+	"backend/ent/organizationmember"
+	"backend/ent/predicate"
+	"backend/ent/projectmember"
+	"backend/ent/schema"
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/ent/taskwatcher"
+	"backend/internal/auth"
+	"backend/internal/logging"
+	"backend/internal/service"
+	"backend/internal/storage"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// TaskHandler handles task-related requests
+type TaskHandler struct {
+	client            *ent.Client
+	emailService      *service.EmailService
+	storage           storage.Store // nil when attachments are disabled
+	maxAttachmentSize int64
+}
+
+// NewTaskHandler creates a new task handler
+func NewTaskHandler(client *ent.Client, emailService *service.EmailService, store storage.Store) *TaskHandler {
+	maxAttachmentSize := int64(defaultMaxAttachmentSize)
+	if v, err := strconv.ParseInt(os.Getenv("ATTACHMENT_MAX_SIZE"), 10, 64); err == nil && v > 0 {
+		maxAttachmentSize = v
+	}
+
+	return &TaskHandler{
+		client:            client,
+		emailService:      emailService,
+		storage:           store,
+		maxAttachmentSize: maxAttachmentSize,
+	}
+}
+
+// CreateTaskRequest represents the request to create a task
+type CreateTaskRequest struct {
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description"`
+	Status      string     `json:"status" validate:"omitempty,oneof=todo in_progress done"`
+	Priority    string     `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	DueDate     *time.Time `json:"due_date"`
+}
+
+// UpdateTaskRequest represents the request to update a task. Omitted fields are left unchanged.
+// For the nullable fields an explicit null clears the value: a null description empties it, a
+// null assignee_id unassigns the task and a null due_date removes it.
+type UpdateTaskRequest struct {
+	Title       *string             `json:"title" validate:"omitempty,min=1"`
+	Description Nullable[string]    `json:"description"`
+	Status      *string             `json:"status" validate:"omitempty,oneof=todo in_progress done"`
+	Priority    *string             `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	AssigneeID  Nullable[string]    `json:"assignee_id"`
+	DueDate     Nullable[time.Time] `json:"due_date"`
+}
+
+// changedFields lists the fields the request sets
+func (r UpdateTaskRequest) changedFields() []string {
+	fields := []string{}
+	if r.Title != nil {
+		fields = append(fields, "title")
+	}
+	if r.Description.Set {
+		fields = append(fields, "description")
+	}
+	if r.Status != nil {
+		fields = append(fields, "status")
+	}
+	if r.Priority != nil {
+		fields = append(fields, "priority")
+	}
+	if r.AssigneeID.Set {
+		fields = append(fields, "assignee_id")
+	}
+	if r.DueDate.Set {
+		fields = append(fields, "due_date")
+	}
+	return fields
+}
+
+// AssignTaskRequest represents the request to assign a task. A null user_id unassigns it.
+type AssignTaskRequest struct {
+	UserID *string `json:"user_id"`
+}
+
+// TaskUserResponse represents a user referenced by a task
+type TaskUserResponse struct {
+	ID          uuid.UUID `json:"id"`
+	DisplayName string    `json:"display_name"`
+	Email       string    `json:"email"`
+}
+
+// TaskResponse represents the task data in responses
+type TaskResponse struct {
+	ID          uuid.UUID         `json:"id"`
+	ProjectID   uuid.UUID         `json:"project_id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Status      string            `json:"status"`
+	Priority    string            `json:"priority"`
+	Assignee    *TaskUserResponse `json:"assignee"`
+	Labels      []LabelResponse   `json:"labels"`
+	DueDate     *time.Time        `json:"due_date"`
+	CreatedByID uuid.UUID         `json:"created_by_id"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	DeletedAt   *time.Time        `json:"deleted_at,omitempty"`
+}
+
+// newTaskResponse builds the response for a task loaded with its assignee and labels edges
+func newTaskResponse(t *ent.Task) TaskResponse {
+	resp := TaskResponse{
+		ID:          t.ID,
+		ProjectID:   t.ProjectID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      string(t.Status),
+		Priority:    string(t.Priority),
+		DueDate:     t.DueDate,
+		CreatedByID: t.CreatedByID,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt,
+		Labels:      make([]LabelResponse, len(t.Edges.Labels)),
+	}
+	for i, l := range t.Edges.Labels {
+		resp.Labels[i] = newLabelResponse(l)
+	}
+	if a := t.Edges.Assignee; a != nil {
+		resp.Assignee = &TaskUserResponse{
+			ID:          a.ID,
+			DisplayName: a.DisplayName,
+			Email:       a.Email,
+		}
+	}
+	return resp
+}
+
+// taskSortFields are the sort keys accepted by ListTasks
+var taskSortFields = SortFields{
+	"position":   {Column: task.FieldPosition, Null: "infinity"},
+	"title":      {Column: task.FieldTitle},
+	"status":     {Column: task.FieldStatus},
+	"priority":   {Column: task.FieldPriority},
+	"due_date":   {Column: task.FieldDueDate, Null: "infinity"},
+	"created_at": {Column: task.FieldCreatedAt},
+	"updated_at": {Column: task.FieldUpdatedAt},
+}
+
+// taskPriorityRanks lists priorities urgent first; a priority's rank is its index
+var taskPriorityRanks = []task.Priority{
+	task.PriorityUrgent,
+	task.PriorityHigh,
+	task.PriorityMedium,
+	task.PriorityLow,
+}
+
+// taskPriorityRank returns the sort rank of a priority, counting from urgent or from low
+func taskPriorityRank(p task.Priority, urgentFirst bool) int {
+	for i, rp := range taskPriorityRanks {
+		if rp == p {
+			if !urgentFirst {
+				return len(taskPriorityRanks) - 1 - i
+			}
+			return i
+		}
+	}
+	return len(taskPriorityRanks)
+}
+
+// taskPrioritySort orders tasks by priority rank rather than alphabetically: ?sort=priority is
+// urgent first and ?sort=-priority low first. Equal priorities are ordered by created_at and id.
+func taskPrioritySort(sortKey string) *sortSpec {
+	urgentFirst := sortKey == "priority"
+	return &sortSpec{
+		Sort:  sortKey,
+		Key:   "priority",
+		width: 2,
+		exprs: func(s *sql.Selector) []string {
+			rank := "CASE " + s.C(task.FieldPriority)
+			for _, p := range taskPriorityRanks {
+				rank += fmt.Sprintf(" WHEN '%s' THEN %d", p, taskPriorityRank(p, urgentFirst))
+			}
+			return []string{rank + " END", s.C(task.FieldCreatedAt)}
+		},
+	}
+}
+
+// taskSortValues returns a task's values for the sort expressions, for building page cursors
+func taskSortValues(spec *sortSpec, t *ent.Task) []any {
+	switch spec.Key {
+	case "priority":
+		return []any{taskPriorityRank(t.Priority, spec.Sort == "priority"), t.CreatedAt}
+	case "title":
+		return []any{t.Title}
+	case "status":
+		return []any{t.Status}
+	case "due_date":
+		if t.DueDate == nil {
+			return []any{taskSortFields.nullSortValue(spec.Key)}
+		}
+		return []any{*t.DueDate}
+	case "position":
+		if t.Position == nil {
+			return []any{taskSortFields.nullSortValue(spec.Key)}
+		}
+		return []any{*t.Position}
+	case "updated_at":
+		return []any{t.UpdatedAt}
+	default:
+		return []any{t.CreatedAt}
+	}
+}
+
+// TaskListResponse represents a page of a project's tasks
+type TaskListResponse struct {
+	Tasks      []TaskResponse `json:"tasks"`
+	NextCursor *string        `json:"next_cursor"`
+}
+
+// getTask loads a task of the project along with its assignee
+func (h *TaskHandler) getTask(ctx context.Context, access *projectAccess, taskIDStr string) (*ent.Task, error) {
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid task_id format")
+	}
+
+	t, err := h.client.Task.Query().
+		Where(
+			task.IDEQ(taskID),
+			task.ProjectIDEQ(access.Project.ID),
+		).
+		WithAssignee().
+		WithLabels(orderLabels).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "task not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get task")
+	}
+	return t, nil
+}
+
+// reloadTask fetches a task again with its assignee after an update
+func (h *TaskHandler) reloadTask(ctx context.Context, id uuid.UUID) (*ent.Task, error) {
+	t, err := h.client.Task.Query().
+		Where(task.IDEQ(id)).
+		WithAssignee().
+		WithLabels(orderLabels).
+		Only(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get task")
+	}
+	return t, nil
+}
+
+// CreateTask creates a new task in a project
+func (h *TaskHandler) CreateTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req CreateTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	status := task.DefaultStatus
+	if req.Status != "" {
+		status = task.Status(req.Status)
+	}
+
+	// New tasks go to the bottom of their column
+	position, err := nextTaskPosition(ctx, h.client.Task, access.Project.ID, status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task position")
+	}
+
+	create := h.client.Task.Create().
+		SetProjectID(access.Project.ID).
+		SetTitle(req.Title).
+		SetDescription(req.Description).
+		SetStatus(status).
+		SetPosition(position).
+		SetCreatedByID(userID).
+		SetNillableDueDate(req.DueDate)
+	if req.Priority != "" {
+		create.SetPriority(task.Priority(req.Priority))
+	}
+
+	t, err := create.Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create task")
+	}
+
+	// The creator follows the task from the start
+	if err := watchTask(ctx, h.client, t.ID, userID); err != nil {
+		logging.FromContext(ctx).Error("failed to watch created task", "task_id", t.ID, "error", err)
+	}
+
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      access.Org.ID,
+		ActorID:    userID,
+		Action:     ActivityTaskCreated,
+		TargetType: ActivityTargetTask,
+		TargetID:   t.ID,
+		Metadata:   taskActivityMetadata(access.Project, t),
+	})
+
+	return c.JSON(http.StatusCreated, newTaskResponse(t))
+}
+
+// taskFilters builds the task predicates for the filter query parameters shared by the task
+// list and export endpoints. Dates are interpreted in the time zone of userID.
+func taskFilters(c echo.Context, client *ent.Client, userID uuid.UUID) ([]predicate.Task, error) {
+	var filters []predicate.Task
+
+	if status := c.QueryParam("status"); status != "" {
+		if err := task.StatusValidator(task.Status(status)); err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "status must be one of: todo, in_progress, done")
+		}
+		filters = append(filters, task.StatusEQ(task.Status(status)))
+	}
+
+	if raw := c.QueryParam("priority"); raw != "" {
+		var priorities []task.Priority
+		for _, p := range strings.Split(raw, ",") {
+			priority := task.Priority(strings.TrimSpace(p))
+			if err := task.PriorityValidator(priority); err != nil {
+				return nil, echo.NewHTTPError(http.StatusBadRequest, "priority must be a comma-separated list of: low, medium, high, urgent")
+			}
+			priorities = append(priorities, priority)
+		}
+		filters = append(filters, task.PriorityIn(priorities...))
+	}
+
+	// Every listed label must be attached to the task
+	if raw := c.QueryParam("label"); raw != "" {
+		for _, name := range strings.Split(raw, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				filters = append(filters, task.HasLabelsWith(label.NameEQ(name)))
+			}
+		}
+	}
+
+	if assignee := c.QueryParam("assignee_id"); assignee != "" {
+		assigneeID, err := uuid.Parse(assignee)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid assignee_id format")
+		}
+		filters = append(filters, task.AssigneeIDEQ(assigneeID))
+	}
+
+	// Overdue tasks are not done and were due on a day before today in the user's time zone, so
+	// a task doesn't turn overdue partway through the day it is due. Tasks without a due date
+	// never match.
+	if c.QueryParam("overdue") == "true" {
+		loc, err := userLocation(c.Request().Context(), client, userID)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get user")
+		}
+		now := time.Now().In(loc)
+		startOfToday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+		filters = append(filters,
+			task.DueDateLT(startOfToday),
+			task.StatusNEQ(task.StatusDone),
+		)
+	}
+
+	if raw := c.QueryParam("due_before"); raw != "" {
+		before, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "due_before must be an RFC3339 timestamp")
+		}
+		filters = append(filters, task.DueDateLT(before))
+	}
+
+	if raw := c.QueryParam("due_after"); raw != "" {
+		after, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "due_after must be an RFC3339 timestamp")
+		}
+		filters = append(filters, task.DueDateGT(after))
+	}
+
+	return filters, nil
+}
+
+// ListTasks lists the tasks of a project
+func (h *TaskHandler) ListTasks(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	spec, err := parseSort(c, taskSortFields, "position")
+	if err != nil {
+		return err
+	}
+	if spec.Key == "priority" {
+		spec = taskPrioritySort(spec.Sort)
+	}
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+	afterCursor, err := parseCursor(c, spec)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	queryCtx, err := includeDeletedContext(c, ctx, access.Membership)
+	if err != nil {
+		return err
+	}
+
+	query := h.client.Task.Query().
+		Where(task.ProjectIDEQ(access.Project.ID))
+
+	filters, err := taskFilters(c, h.client, userID)
+	if err != nil {
+		return err
+	}
+	query.Where(filters...)
+
+	if afterCursor != nil {
+		query.Where(afterCursor)
+	}
+
+	// Fetch one extra row to know whether there is a next page
+	tasks, err := query.
+		WithAssignee().
+		WithLabels(orderLabels).
+		Order(spec.Order).
+		Limit(limit + 1).
+		All(queryCtx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list tasks")
+	}
+
+	var nextCursor *string
+	if len(tasks) > limit {
+		tasks = tasks[:limit]
+		last := tasks[len(tasks)-1]
+		nextCursor = encodeCursor(spec, taskSortValues(spec, last), last.ID)
+	}
+
+	result := make([]TaskResponse, len(tasks))
+	for i, t := range tasks {
+		result[i] = newTaskResponse(t)
+	}
+
+	return c.JSON(http.StatusOK, TaskListResponse{
+		Tasks:      result,
+		NextCursor: nextCursor,
+	})
+}
+
+// GetTask gets a single task
+func (h *TaskHandler) GetTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
+
+// UpdateTask updates a task's title, description or status
+func (h *TaskHandler) UpdateTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req UpdateTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	var assigneeID *uuid.UUID
+	if req.AssigneeID.Value != nil {
+		id, err := uuid.Parse(*req.AssigneeID.Value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid assignee_id format")
+		}
+		assigneeID = &id
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	// Assignee must belong to the project's organization
+	if assigneeID != nil {
+		isMember, err := h.client.OrganizationMember.Query().
+			Where(
+				organizationmember.UserIDEQ(*assigneeID),
+				organizationmember.OrganizationIDEQ(access.Org.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+		}
+		if !isMember {
+			return echo.NewHTTPError(http.StatusBadRequest, "user is not a member of this organization")
+		}
+	}
+
+	// Update the task and record a status transition in the same transaction
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		current, err := tx.Task.Get(ctx, t.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task").SetInternal(err)
+		}
+
+		update := tx.Task.UpdateOne(current)
+		if req.Title != nil {
+			update.SetTitle(*req.Title)
+		}
+		if req.Description.Set {
+			if req.Description.Value == nil {
+				update.SetDescription("")
+			} else {
+				update.SetDescription(*req.Description.Value)
+			}
+		}
+		if req.Status != nil && task.Status(*req.Status) != current.Status {
+			// A task changing column goes to the bottom of its new one
+			position, err := nextTaskPosition(ctx, tx.Task, current.ProjectID, task.Status(*req.Status))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task position").SetInternal(err)
+			}
+			update.SetStatus(task.Status(*req.Status)).
+				SetPosition(position)
+		}
+		if req.Priority != nil {
+			update.SetPriority(task.Priority(*req.Priority))
+		}
+		if req.AssigneeID.Set {
+			if assigneeID == nil {
+				update.ClearAssigneeID()
+			} else {
+				update.SetAssigneeID(*assigneeID)
+			}
+		}
+		if req.DueDate.Set {
+			if req.DueDate.Value == nil {
+				update.ClearDueDate()
+			} else {
+				update.SetDueDate(*req.DueDate.Value)
+			}
+		}
+
+		if _, err := update.Save(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to update task").SetInternal(err)
+		}
+
+		// Only actual transitions are recorded
+		if req.Status != nil && task.Status(*req.Status) != current.Status {
+			_, err = tx.TaskStatusChange.Create().
+				SetTaskID(current.ID).
+				SetFromStatus(taskstatuschange.FromStatus(current.Status)).
+				SetToStatus(taskstatuschange.ToStatus(*req.Status)).
+				SetChangedByID(userID).
+				Save(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to record status change").SetInternal(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The assignee follows the task they were given
+	if assigneeID != nil {
+		if err := watchTask(ctx, h.client, t.ID, *assigneeID); err != nil {
+			logging.FromContext(ctx).Error("failed to watch assigned task", "task_id", t.ID, "error", err)
+		}
+	}
+	newlyAssigned := assigneeID != nil && (t.AssigneeID == nil || *t.AssigneeID != *assigneeID)
+
+	t, err = h.reloadTask(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	if newlyAssigned {
+		payload := taskActivityMetadata(access.Project, t)
+		payload["task_id"] = t.ID
+		payload["organization_slug"] = access.Org.Slug
+		notify(ctx, h.client, notificationEntry{
+			UserID:  *assigneeID,
+			ActorID: userID,
+			Type:    NotificationTaskAssigned,
+			Payload: payload,
+		})
+	}
+	metadata := taskActivityMetadata(access.Project, t)
+	metadata["fields"] = req.changedFields()
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      access.Org.ID,
+		ActorID:    userID,
+		Action:     ActivityTaskUpdated,
+		TargetType: ActivityTargetTask,
+		TargetID:   t.ID,
+		Metadata:   metadata,
+	})
+	h.notifyWatchers(ctx, requestLocale(c), access, t, taskChange{ActorID: userID})
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
+
+// DeleteTask deletes a task
+func (h *TaskHandler) DeleteTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	// Soft delete: comments and history are kept so the task can be restored
+	if err := h.client.Task.UpdateOneID(t.ID).
+		SetDeletedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete task")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RestoreTask undoes the deletion of a task (edit permission required)
+func (h *TaskHandler) RestoreTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	taskID, err := uuid.Parse(c.Param("task_id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid task_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.client.Task.Query().
+		Where(
+			task.IDEQ(taskID),
+			task.ProjectIDEQ(access.Project.ID),
+		).
+		Only(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "task not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task")
+	}
+	if t.DeletedAt == nil {
+		return echo.NewHTTPError(http.StatusConflict, "task is not deleted")
+	}
+
+	if err := t.Update().ClearDeletedAt().Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to restore task")
+	}
+
+	t, err = h.reloadTask(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
+
+// MoveTaskRequest represents the request to move a task to another project
+type MoveTaskRequest struct {
+	ProjectID string `json:"project_id" validate:"required"`
+}
+
+// MoveTask moves a task to another project of the same organization (edit permission required
+// on both). Labels are project-scoped, so each label is swapped for the destination project's
+// label of the same name, and dropped when there is none.
+func (h *TaskHandler) MoveTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req MoveTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	destID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid project_id format")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+	if destID == access.Project.ID {
+		return c.JSON(http.StatusOK, newTaskResponse(t))
+	}
+
+	dest, err := h.client.Project.Get(ctx, destID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "project not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project")
+	}
+	if dest.OrganizationID != access.Org.ID {
+		return echo.NewHTTPError(http.StatusBadRequest, "tasks can only be moved within their organization")
+	}
+
+	permission, hasAccess, err := EffectiveProjectPermission(ctx, h.client, access.Org, access.Membership, dest)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check project membership")
+	}
+	if !hasAccess {
+		return errNoProjectAccess()
+	}
+	destAccess := &projectAccess{
+		Org:        access.Org,
+		Membership: access.Membership,
+		Project:    dest,
+		Permission: projectmember.Permission(permission),
+	}
+	if err := destAccess.requireEdit(); err != nil {
+		return err
+	}
+
+	labelNames := make([]string, len(t.Edges.Labels))
+	for i, l := range t.Edges.Labels {
+		labelNames[i] = l.Name
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		destLabels, err := tx.Label.Query().
+			Where(
+				label.ProjectIDEQ(dest.ID),
+				label.NameIn(labelNames...),
+			).
+			IDs(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get labels").SetInternal(err)
+		}
+
+		// The task goes to the bottom of its column in the destination
+		position, err := nextTaskPosition(ctx, tx.Task, dest.ID, t.Status)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task position").SetInternal(err)
+		}
+
+		err = tx.Task.UpdateOneID(t.ID).
+			SetProjectID(dest.ID).
+			SetPosition(position).
+			ClearLabels().
+			AddLabelIDs(destLabels...).
+			Exec(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to move task").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	t, err = h.reloadTask(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	metadata := taskActivityMetadata(dest, t)
+	metadata["fields"] = []string{"project_id"}
+	metadata["from_project_id"] = access.Project.ID
+	recordActivity(ctx, h.client, activityEntry{
+		OrgID:      access.Org.ID,
+		ActorID:    userID,
+		Action:     ActivityTaskUpdated,
+		TargetType: ActivityTargetTask,
+		TargetID:   t.ID,
+		Metadata:   metadata,
+	})
+	h.notifyWatchers(ctx, requestLocale(c), destAccess, t, taskChange{ActorID: userID})
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
+
+// ReorderTaskRequest represents the request to move a task within its status column.
+// A null after_task_id moves the task to the top.
+type ReorderTaskRequest struct {
+	AfterTaskID *string `json:"after_task_id"`
+}
+
+// ReorderTask moves a task directly after another task of the same status column (edit permission required)
+func (h *TaskHandler) ReorderTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req ReorderTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	var afterID *uuid.UUID
+	if req.AfterTaskID != nil {
+		id, err := uuid.Parse(*req.AfterTaskID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid after_task_id format")
+		}
+		if id == t.ID {
+			return echo.NewHTTPError(http.StatusBadRequest, "a task cannot be placed after itself")
+		}
+		afterID = &id
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		// Load the column's other tasks in their current order
+		siblings, err := tx.Task.Query().
+			Where(
+				task.ProjectIDEQ(access.Project.ID),
+				task.StatusEQ(t.Status),
+				task.IDNEQ(t.ID),
+			).
+			Order(ent.Asc(task.FieldPosition, task.FieldCreatedAt, task.FieldID)).
+			All(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to list tasks").SetInternal(err)
+		}
+
+		// Find the insertion index: right after after_task_id, or at the top
+		index := 0
+		if afterID != nil {
+			index = -1
+			for i, other := range siblings {
+				if other.ID == *afterID {
+					index = i + 1
+					break
+				}
+			}
+			if index == -1 {
+				return echo.NewHTTPError(http.StatusBadRequest, "after_task_id is not a task in the same column")
+			}
+		}
+
+		var prev, next *float64
+		if index > 0 {
+			prev = siblings[index-1].Position
+		}
+		if index < len(siblings) {
+			next = siblings[index].Position
+		}
+
+		position, ok := positionBetween(prev, next)
+		if !ok {
+			// Neighbours are too close to split: respace the whole column, leaving a slot for the task
+			for i, other := range siblings {
+				slot := i + 1
+				if i >= index {
+					slot++
+				}
+				if err := tx.Task.UpdateOne(other).SetPosition(float64(slot) * positionGap).Exec(ctx); err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "failed to reorder tasks").SetInternal(err)
+				}
+			}
+			position = float64(index+1) * positionGap
+		}
+
+		if err := tx.Task.UpdateOneID(t.ID).SetPosition(position).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to reorder task").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// deleteTasksTx permanently deletes the tasks matching where together with their status
+// history, comments and attachment metadata, inside the caller's transaction. Attached files
+// are left in storage.
+func deleteTasksTx(ctx context.Context, tx *ent.Tx, where predicate.Task) error {
+	if _, err := tx.TaskWatcher.Delete().
+		Where(taskwatcher.HasTaskWith(where)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete task watchers").SetInternal(err)
+	}
+
+	if _, err := tx.TaskStatusChange.Delete().
+		Where(taskstatuschange.HasTaskWith(where)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete task history").SetInternal(err)
+	}
+
+	if _, err := tx.Attachment.Delete().
+		Where(attachment.HasTaskWith(where)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete task attachments").SetInternal(err)
+	}
+
+	if _, err := tx.Comment.Delete().
+		Where(comment.HasTaskWith(where)).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete task comments").SetInternal(err)
+	}
+
+	if _, err := tx.Task.Delete().
+		Where(where).
+		Exec(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete tasks").SetInternal(err)
+	}
+	return nil
+}
+
+// AssignTask assigns a task to a member of the project's organization, or unassigns it
+func (h *TaskHandler) AssignTask(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req AssignTaskRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	var assigneeID *uuid.UUID
+	if req.UserID != nil {
+		id, err := uuid.Parse(*req.UserID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid user_id format")
+		}
+		assigneeID = &id
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+	if err := access.requireEdit(); err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	update := t.Update()
+	if assigneeID == nil {
+		update.ClearAssigneeID()
+	} else {
+		// Assignee must belong to the project's organization
+		isMember, err := h.client.OrganizationMember.Query().
+			Where(
+				organizationmember.UserIDEQ(*assigneeID),
+				organizationmember.OrganizationIDEQ(access.Org.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+		}
+		if !isMember {
+			return echo.NewHTTPError(http.StatusBadRequest, "user is not a member of this organization")
+		}
+		update.SetAssigneeID(*assigneeID)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to assign task")
+	}
+
+	// The assignee follows the task they were given
+	if assigneeID != nil {
+		if err := watchTask(ctx, h.client, t.ID, *assigneeID); err != nil {
+			logging.FromContext(ctx).Error("failed to watch assigned task", "task_id", t.ID, "error", err)
+		}
+	}
+	newlyAssigned := assigneeID != nil && (t.AssigneeID == nil || *t.AssigneeID != *assigneeID)
+
+	t, err = h.reloadTask(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	if newlyAssigned {
+		payload := taskActivityMetadata(access.Project, t)
+		payload["task_id"] = t.ID
+		payload["organization_slug"] = access.Org.Slug
+		notify(ctx, h.client, notificationEntry{
+			UserID:  *assigneeID,
+			ActorID: userID,
+			Type:    NotificationTaskAssigned,
+			Payload: payload,
+		})
+	}
+	h.notifyWatchers(ctx, requestLocale(c), access, t, taskChange{ActorID: userID})
+
+	return c.JSON(http.StatusOK, newTaskResponse(t))
+}
+
+// TaskStatusChangeResponse represents one status transition in a task's history
+type TaskStatusChangeResponse struct {
+	ID         uuid.UUID        `json:"id"`
+	FromStatus string           `json:"from_status"`
+	ToStatus   string           `json:"to_status"`
+	ChangedBy  TaskUserResponse `json:"changed_by"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// GetTaskHistory lists a task's status transitions, oldest first
+func (h *TaskHandler) GetTaskHistory(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	changes, err := h.client.TaskStatusChange.Query().
+		Where(taskstatuschange.TaskIDEQ(t.ID)).
+		WithChangedBy().
+		Order(ent.Asc(taskstatuschange.FieldCreatedAt, taskstatuschange.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task history")
+	}
+
+	result := make([]TaskStatusChangeResponse, len(changes))
+	for i, ch := range changes {
+		result[i] = TaskStatusChangeResponse{
+			ID:         ch.ID,
+			FromStatus: string(ch.FromStatus),
+			ToStatus:   string(ch.ToStatus),
+			ChangedBy: TaskUserResponse{
+				ID:          ch.Edges.ChangedBy.ID,
+				DisplayName: ch.Edges.ChangedBy.DisplayName,
+				Email:       ch.Edges.ChangedBy.Email,
+			},
+			CreatedAt: ch.CreatedAt,
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/ent/project"
+	"backend/ent/projectmember"
+	"backend/ent/task"
+	"backend/ent/taskstatuschange"
+	"backend/internal/auth"
+	"backend/internal/logging"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// maxBulkTasks caps the tasks updated by one bulk request
+const maxBulkTasks = 100
+
+// Bulk task update result statuses
+const (
+	bulkTaskUpdated = "updated"
+	bulkTaskFailed  = "failed"
+)
+
+// BulkTaskSet lists the fields a bulk update sets on every task. Omitted fields are left
+// unchanged; a null assignee_id unassigns the tasks.
+type BulkTaskSet struct {
+	Status     *string          `json:"status" validate:"omitempty,oneof=todo in_progress done"`
+	Priority   *string          `json:"priority" validate:"omitempty,oneof=low medium high urgent"`
+	AssigneeID Nullable[string] `json:"assignee_id"`
+}
+
+// changedFields lists the fields the update sets
+func (s BulkTaskSet) changedFields() []string {
+	fields := []string{}
+	if s.Status != nil {
+		fields = append(fields, "status")
+	}
+	if s.Priority != nil {
+		fields = append(fields, "priority")
+	}
+	if s.AssigneeID.Set {
+		fields = append(fields, "assignee_id")
+	}
+	return fields
+}
+
+// BulkUpdateTasksRequest represents the request to update several tasks at once
+type BulkUpdateTasksRequest struct {
+	TaskIDs []string    `json:"task_ids" validate:"required,min=1"`
+	Set     BulkTaskSet `json:"set"`
+}
+
+// BulkTaskResult reports the outcome for one task of a bulk update
+type BulkTaskResult struct {
+	TaskID string        `json:"task_id"`
+	Status string        `json:"status"`
+	Reason string        `json:"reason,omitempty"`
+	Task   *TaskResponse `json:"task,omitempty"`
+}
+
+// BulkUpdateTasksResponse represents the response of a bulk update
+type BulkUpdateTasksResponse struct {
+	Results []BulkTaskResult `json:"results"`
+}
+
+// BulkUpdateTasks applies the same change to several tasks of an organization, which may be in
+// different projects. Tasks the caller can't edit are reported as failed; the others are
+// updated together in one transaction.
+func (h *TaskHandler) BulkUpdateTasks(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req BulkUpdateTasksRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+
+	fields := req.Set.changedFields()
+	if len(fields) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "set must change at least one field")
+	}
+
+	var assigneeID *uuid.UUID
+	if req.Set.AssigneeID.Value != nil {
+		id, err := uuid.Parse(*req.Set.AssigneeID.Value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid assignee_id format")
+		}
+		assigneeID = &id
+	}
+
+	// Deduplicate while keeping the order the ids were given in
+	seen := make(map[string]bool, len(req.TaskIDs))
+	var rawIDs []string
+	for _, raw := range req.TaskIDs {
+		if seen[raw] {
+			continue
+		}
+		seen[raw] = true
+		rawIDs = append(rawIDs, raw)
+	}
+	if len(rawIDs) > maxBulkTasks {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("at most %d tasks can be updated at once", maxBulkTasks))
+	}
+
+	ctx := c.Request().Context()
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return err
+	}
+	if err := requireWriteAccess(membership); err != nil {
+		return err
+	}
+
+	// Assignee must belong to the organization
+	if assigneeID != nil {
+		isMember, err := h.client.OrganizationMember.Query().
+			Where(
+				organizationmember.UserIDEQ(*assigneeID),
+				organizationmember.OrganizationIDEQ(org.ID),
+			).
+			Exist(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check membership")
+		}
+		if !isMember {
+			return echo.NewHTTPError(http.StatusBadRequest, "user is not a member of this organization")
+		}
+	}
+
+	results := make([]BulkTaskResult, len(rawIDs))
+	parsed := make([]uuid.UUID, len(rawIDs))
+	var ids []uuid.UUID
+	for i, raw := range rawIDs {
+		results[i] = BulkTaskResult{TaskID: raw}
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			results[i].Status = bulkTaskFailed
+			results[i].Reason = "invalid task id"
+			continue
+		}
+		parsed[i] = id
+		ids = append(ids, id)
+	}
+
+	tasks, err := h.client.Task.Query().
+		Where(
+			task.IDIn(ids...),
+			task.HasProjectWith(project.OrganizationIDEQ(org.ID)),
+		).
+		WithProject().
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get tasks")
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(tasks))
+	for _, t := range tasks {
+		projectIDs = append(projectIDs, t.ProjectID)
+	}
+	explicit, err := explicitProjectPermissions(ctx, h.client, userID, projectIDs)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get project memberships")
+	}
+
+	// Tasks of projects the caller can't see are reported as not found
+	editable := make(map[uuid.UUID]*ent.Task, len(tasks))
+	reasons := make(map[uuid.UUID]string, len(tasks))
+	for _, t := range tasks {
+		perm, ok := projectPermission(org, membership, t.Edges.Project, explicit[t.ProjectID])
+		switch {
+		case !ok:
+		case perm != projectmember.PermissionEdit:
+			reasons[t.ID] = "you do not have edit permission for this project"
+		default:
+			editable[t.ID] = t
+		}
+	}
+
+	var updateIDs []uuid.UUID
+	for i := range rawIDs {
+		if results[i].Status != "" {
+			continue
+		}
+		id := parsed[i]
+		if editable[id] == nil {
+			results[i].Status = bulkTaskFailed
+			results[i].Reason = reasons[id]
+			if results[i].Reason == "" {
+				results[i].Reason = "task not found"
+			}
+			continue
+		}
+		updateIDs = append(updateIDs, id)
+	}
+
+	// Update the tasks and record their status transitions in one transaction
+	if len(updateIDs) > 0 {
+		err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+			update := tx.Task.Update().Where(task.IDIn(updateIDs...))
+			if req.Set.Status != nil {
+				update.SetStatus(task.Status(*req.Set.Status))
+			}
+			if req.Set.Priority != nil {
+				update.SetPriority(task.Priority(*req.Set.Priority))
+			}
+			if req.Set.AssigneeID.Set {
+				if assigneeID == nil {
+					update.ClearAssigneeID()
+				} else {
+					update.SetAssigneeID(*assigneeID)
+				}
+			}
+			if _, err := update.Save(ctx); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to update tasks").SetInternal(err)
+			}
+
+			// Only actual transitions are recorded. Tasks changing column go to the bottom
+			// of their new one, in the order they were listed.
+			if req.Set.Status != nil {
+				status := task.Status(*req.Set.Status)
+				var changes []*ent.TaskStatusChangeCreate
+				positions := make(map[uuid.UUID]float64)
+				for _, id := range updateIDs {
+					current := editable[id]
+					if status == current.Status {
+						continue
+					}
+
+					position, ok := positions[current.ProjectID]
+					if !ok {
+						var err error
+						position, err = nextTaskPosition(ctx, tx.Task, current.ProjectID, status)
+						if err != nil {
+							return echo.NewHTTPError(http.StatusInternalServerError, "failed to get task position").SetInternal(err)
+						}
+					}
+					if err := tx.Task.UpdateOneID(id).SetPosition(position).Exec(ctx); err != nil {
+						return echo.NewHTTPError(http.StatusInternalServerError, "failed to update tasks").SetInternal(err)
+					}
+					positions[current.ProjectID] = position + positionGap
+
+					changes = append(changes, tx.TaskStatusChange.Create().
+						SetTaskID(id).
+						SetFromStatus(taskstatuschange.FromStatus(current.Status)).
+						SetToStatus(taskstatuschange.ToStatus(*req.Set.Status)).
+						SetChangedByID(userID))
+				}
+				if len(changes) > 0 {
+					if _, err := tx.TaskStatusChange.CreateBulk(changes...).Save(ctx); err != nil {
+						return echo.NewHTTPError(http.StatusInternalServerError, "failed to record status changes").SetInternal(err)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	updated, err := h.client.Task.Query().
+		Where(task.IDIn(updateIDs...)).
+		WithAssignee().
+		WithLabels(orderLabels).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get tasks")
+	}
+	updatedMap := make(map[uuid.UUID]*ent.Task, len(updated))
+	for _, t := range updated {
+		updatedMap[t.ID] = t
+	}
+
+	locale := requestLocale(c)
+	for i := range rawIDs {
+		if results[i].Status != "" {
+			continue
+		}
+		id := parsed[i]
+		t := updatedMap[id]
+		if t == nil {
+			// Deleted between the update and the reload
+			results[i].Status = bulkTaskFailed
+			results[i].Reason = "task not found"
+			continue
+		}
+		resp := newTaskResponse(t)
+		results[i].Status = bulkTaskUpdated
+		results[i].Task = &resp
+
+		previous := editable[id]
+		proj := previous.Edges.Project
+		access := &projectAccess{Org: org, Membership: membership, Project: proj, Permission: projectmember.PermissionEdit}
+
+		if assigneeID != nil {
+			if err := watchTask(ctx, h.client, id, *assigneeID); err != nil {
+				logging.FromContext(ctx).Error("failed to watch assigned task", "task_id", id, "error", err)
+			}
+			if previous.AssigneeID == nil || *previous.AssigneeID != *assigneeID {
+				payload := taskActivityMetadata(proj, t)
+				payload["task_id"] = t.ID
+				payload["organization_slug"] = org.Slug
+				notify(ctx, h.client, notificationEntry{
+					UserID:  *assigneeID,
+					ActorID: userID,
+					Type:    NotificationTaskAssigned,
+					Payload: payload,
+				})
+			}
+		}
+
+		metadata := taskActivityMetadata(proj, t)
+		metadata["fields"] = fields
+		recordActivity(ctx, h.client, activityEntry{
+			OrgID:      org.ID,
+			ActorID:    userID,
+			Action:     ActivityTaskUpdated,
+			TargetType: ActivityTargetTask,
+			TargetID:   t.ID,
+			Metadata:   metadata,
+		})
+		h.notifyWatchers(ctx, locale, access, t, taskChange{ActorID: userID})
+	}
+
+	return c.JSON(http.StatusOK, BulkUpdateTasksResponse{Results: results})
+}
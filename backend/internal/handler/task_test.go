@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/internal/service"
+)
+
+func TestUpdateTaskNullableFields(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewTaskHandler(client, emailService, nil)
+
+	owner := createTestUser(t, client, "owner@example.com")
+	assignee := createTestUser(t, client, "assignee@example.com")
+	outsider := createTestUser(t, client, "outsider@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	addTestMember(t, client, org, assignee, organizationmember.RoleMember)
+	proj := createTestProject(t, client, org, "Project", false)
+	due := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		body  string
+		check func(t *testing.T, task *ent.Task)
+		code  int
+	}{
+		{
+			name: "omitted fields are unchanged",
+			body: `{"title":"Renamed"}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.Description != "details" || task.AssigneeID == nil || task.DueDate == nil {
+					t.Errorf("fields changed: description %q, assignee %v, due date %v", task.Description, task.AssigneeID, task.DueDate)
+				}
+			},
+		},
+		{
+			name: "null description empties it",
+			body: `{"description":null}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.Description != "" {
+					t.Errorf("description %q, want empty", task.Description)
+				}
+			},
+		},
+		{
+			name: "description is set",
+			body: `{"description":"new"}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.Description != "new" {
+					t.Errorf("description %q, want %q", task.Description, "new")
+				}
+			},
+		},
+		{
+			name: "null assignee_id unassigns",
+			body: `{"assignee_id":null}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.AssigneeID != nil {
+					t.Errorf("assignee %v, want none", *task.AssigneeID)
+				}
+			},
+		},
+		{
+			name: "assignee_id is set",
+			body: `{"assignee_id":"` + owner.ID.String() + `"}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.AssigneeID == nil || *task.AssigneeID != owner.ID {
+					t.Errorf("assignee %v, want %v", task.AssigneeID, owner.ID)
+				}
+			},
+		},
+		{
+			name: "assignee_id outside the organization is rejected",
+			body: `{"assignee_id":"` + outsider.ID.String() + `"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "invalid assignee_id is rejected",
+			body: `{"assignee_id":"nobody"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "null due_date removes it",
+			body: `{"due_date":null}`,
+			check: func(t *testing.T, task *ent.Task) {
+				if task.DueDate != nil {
+					t.Errorf("due date %v, want none", *task.DueDate)
+				}
+			},
+		},
+		{
+			name: "due_date is set",
+			body: `{"due_date":"2026-02-03T00:00:00Z"}`,
+			check: func(t *testing.T, task *ent.Task) {
+				want := time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC)
+				if task.DueDate == nil || !task.DueDate.Equal(want) {
+					t.Errorf("due date %v, want %v", task.DueDate, want)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task, err := client.Task.Create().
+				SetProjectID(proj.ID).
+				SetTitle("Task").
+				SetDescription("details").
+				SetCreatedByID(owner.ID).
+				SetAssigneeID(assignee.ID).
+				SetDueDate(due).
+				Save(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c, rec := newTestContext(t, testRequest{
+				Method: http.MethodPatch,
+				Params: map[string]string{"slug": org.Slug, "project_id": proj.ID.String(), "task_id": task.ID.String()},
+				Body:   json.RawMessage(tt.body),
+				UserID: owner.ID,
+			})
+			want := tt.code
+			if want == 0 {
+				want = http.StatusOK
+			}
+			if status := statusOf(t, h.UpdateTask(c), rec); status != want {
+				t.Fatalf("status %d, want %d", status, want)
+			}
+			if tt.check == nil {
+				return
+			}
+
+			task, err = client.Task.Get(ctx, task.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, task)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/ent"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lib/pq"
+)
+
+// maxTxAttempts is how many times withTx runs a transaction that keeps hitting serialization failures
+const maxTxAttempts = 3
+
+// withTx runs fn in a transaction, committing when it returns nil and rolling back otherwise.
+// Transactions aborted by Postgres for a serialization failure or deadlock are retried, so fn
+// must be safe to run more than once. Errors from fn are returned as-is; attach the underlying
+// database error with SetInternal so that it can be recognised as retryable.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	var err error
+	for attempt := 1; attempt <= maxTxAttempts; attempt++ {
+		err = runTx(ctx, client, fn)
+		if err == nil || !isRetryableTxError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt) * 10 * time.Millisecond):
+		}
+	}
+	return err
+}
+
+func runTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to start transaction").SetInternal(err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to commit transaction").SetInternal(err)
+	}
+	return nil
+}
+
+// isRetryableTxError reports whether err is a Postgres serialization failure (40001) or deadlock (40P01)
+func isRetryableTxError(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == "40001" || pqErr.Code == "40P01"
+}
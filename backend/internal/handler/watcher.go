@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/ent/projectmember"
+	"backend/ent/taskwatcher"
+	"backend/internal/auth"
+	"backend/internal/logging"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// watchTask makes a user a watcher of a task, doing nothing if they already are
+func watchTask(ctx context.Context, client *ent.Client, taskID, userID uuid.UUID) error {
+	exists, err := client.TaskWatcher.Query().
+		Where(
+			taskwatcher.TaskIDEQ(taskID),
+			taskwatcher.UserIDEQ(userID),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	err = client.TaskWatcher.Create().
+		SetTaskID(taskID).
+		SetUserID(userID).
+		Exec(ctx)
+	// A constraint error means a concurrent request added the watcher first
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
+
+// taskChange describes a change to a task for the emails sent to its watchers. Comment is
+// empty for updates to the task itself.
+type taskChange struct {
+	ActorID uuid.UUID
+	Comment string
+}
+
+// notifyWatchers queues an email to every watcher of a task except the actor, in the
+// background like recordActivity. Watchers who have since lost access to the project, for
+// example by leaving the organization, are skipped.
+func (h *TaskHandler) notifyWatchers(ctx context.Context, locale string, access *projectAccess, t *ent.Task, change taskChange) {
+	logger := logging.FromContext(ctx)
+	org, proj := access.Org, access.Project
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		watchers, err := h.client.TaskWatcher.Query().
+			Where(
+				taskwatcher.TaskIDEQ(t.ID),
+				taskwatcher.UserIDNEQ(change.ActorID),
+			).
+			WithUser().
+			All(ctx)
+		if err != nil {
+			logger.Error("failed to load task watchers", "task_id", t.ID, "error", err)
+			return
+		}
+		if len(watchers) == 0 {
+			return
+		}
+
+		userIDs := make([]uuid.UUID, len(watchers))
+		for i, w := range watchers {
+			userIDs[i] = w.UserID
+		}
+
+		memberships, err := h.client.OrganizationMember.Query().
+			Where(
+				organizationmember.OrganizationIDEQ(org.ID),
+				organizationmember.UserIDIn(userIDs...),
+			).
+			All(ctx)
+		if err != nil {
+			logger.Error("failed to load watcher memberships", "task_id", t.ID, "error", err)
+			return
+		}
+		membershipMap := make(map[uuid.UUID]*ent.OrganizationMember, len(memberships))
+		for _, m := range memberships {
+			membershipMap[m.UserID] = m
+		}
+
+		projectMemberships, err := h.client.ProjectMember.Query().
+			Where(
+				projectmember.ProjectIDEQ(proj.ID),
+				projectmember.UserIDIn(userIDs...),
+			).
+			All(ctx)
+		if err != nil {
+			logger.Error("failed to load watcher project memberships", "task_id", t.ID, "error", err)
+			return
+		}
+		explicit := make(map[uuid.UUID]*projectmember.Permission, len(projectMemberships))
+		for _, pm := range projectMemberships {
+			explicit[pm.UserID] = &pm.Permission
+		}
+
+		actorName := ""
+		if actor, err := h.client.User.Get(ctx, change.ActorID); err == nil {
+			actorName = actor.DisplayName
+		}
+
+		for _, w := range watchers {
+			membership := membershipMap[w.UserID]
+			if membership == nil || w.Edges.User == nil {
+				continue
+			}
+			if _, ok := projectPermission(org, membership, proj, explicit[w.UserID]); !ok {
+				continue
+			}
+
+			to := w.Edges.User.Email
+			if change.Comment != "" {
+				err = h.emailService.SendTaskCommentedEmail(ctx, locale, to, actorName, t.Title, proj.Name, org.Slug, proj.ID.String(), change.Comment)
+			} else {
+				err = h.emailService.SendTaskUpdatedEmail(ctx, locale, to, actorName, t.Title, proj.Name, org.Slug, proj.ID.String())
+			}
+			if err != nil {
+				logger.Error("failed to notify task watcher", "task_id", t.ID, "user_id", w.UserID, "error", err)
+			}
+		}
+	}()
+}
+
+// ListWatchers lists the users watching a task, in the order they started watching
+func (h *TaskHandler) ListWatchers(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	watchers, err := h.client.TaskWatcher.Query().
+		Where(taskwatcher.TaskIDEQ(t.ID)).
+		WithUser().
+		Order(ent.Asc(taskwatcher.FieldCreatedAt, taskwatcher.FieldID)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get watchers")
+	}
+
+	result := make([]TaskUserResponse, 0, len(watchers))
+	for _, w := range watchers {
+		u := w.Edges.User
+		if u == nil {
+			continue
+		}
+		result = append(result, TaskUserResponse{
+			ID:          u.ID,
+			DisplayName: u.DisplayName,
+			Email:       u.Email,
+		})
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// WatchTask makes the caller a watcher of a task. Anyone who can see the task may watch it.
+func (h *TaskHandler) WatchTask(c echo.Context) error {
+	return h.setWatching(c, true)
+}
+
+// UnwatchTask stops the caller watching a task
+func (h *TaskHandler) UnwatchTask(c echo.Context) error {
+	return h.setWatching(c, false)
+}
+
+// setWatching adds or removes the caller as a watcher of a task. Both are idempotent.
+func (h *TaskHandler) setWatching(c echo.Context, watch bool) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	access, err := loadProjectAccess(ctx, h.client, userID, c.Param("slug"), c.Param("project_id"))
+	if err != nil {
+		return err
+	}
+
+	t, err := h.getTask(ctx, access, c.Param("task_id"))
+	if err != nil {
+		return err
+	}
+
+	if watch {
+		err = watchTask(ctx, h.client, t.ID, userID)
+	} else {
+		_, err = h.client.TaskWatcher.Delete().
+			Where(
+				taskwatcher.TaskIDEQ(t.ID),
+				taskwatcher.UserIDEQ(userID),
+			).
+			Exec(ctx)
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update watchers")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
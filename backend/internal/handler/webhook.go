@@ -0,0 +1,491 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/webhook"
+	"backend/ent/webhookdelivery"
+	"backend/internal/auth"
+	"backend/internal/logging"
+	"backend/internal/service"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// webhookEvents are the events a webhook can subscribe to. Every activity action is an event.
+var webhookEvents = []string{
+	ActivityTaskCreated,
+	ActivityTaskUpdated,
+	ActivityProjectCreated,
+	ActivityMemberAdded,
+	ActivityMemberRemoved,
+	ActivityInviteSent,
+}
+
+// CreateWebhookRequest represents the request to register a webhook
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,http_url,max=2048"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+}
+
+// UpdateWebhookRequest represents the request to update a webhook. Omitted fields are left unchanged.
+type UpdateWebhookRequest struct {
+	URL    *string  `json:"url" validate:"omitempty,http_url,max=2048"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+}
+
+// WebhookResponse represents a webhook. The secret is only returned when the webhook is created.
+type WebhookResponse struct {
+	ID        uuid.UUID `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newWebhookResponse(wh *ent.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        wh.ID,
+		URL:       wh.URL,
+		Events:    wh.Events,
+		Active:    wh.Active,
+		CreatedAt: wh.CreatedAt,
+		UpdatedAt: wh.UpdatedAt,
+	}
+}
+
+// WebhookDeliveryResponse represents one queued or attempted webhook delivery
+type WebhookDeliveryResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	Event         string     `json:"event"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	StatusCode    *int       `json:"status_code"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// webhookPayload is the JSON body posted to webhooks
+type webhookPayload struct {
+	ID             uuid.UUID      `json:"id"`
+	Event          string         `json:"event"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	ActorID        uuid.UUID      `json:"actor_id"`
+	TargetType     string         `json:"target_type"`
+	TargetID       uuid.UUID      `json:"target_id"`
+	Data           map[string]any `json:"data,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// validateWebhookEvents checks that events is a non-empty list of known events
+func validateWebhookEvents(events []string) error {
+	if len(events) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "events must include at least one event")
+	}
+	for _, event := range events {
+		if !slices.Contains(webhookEvents, event) {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown event: "+event+" (must be one of: "+strings.Join(webhookEvents, ", ")+")")
+		}
+	}
+	return nil
+}
+
+// checkWebhookURL rejects webhook URLs whose host is or resolves to a loopback, private or
+// link-local address
+func checkWebhookURL(ctx context.Context, rawURL string) error {
+	if err := service.CheckWebhookURL(ctx, rawURL); err != nil {
+		if errors.Is(err, service.ErrPrivateWebhookAddress) {
+			return echo.NewHTTPError(http.StatusBadRequest, "url must not point to a loopback, private or link-local address")
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, "url host could not be resolved").SetInternal(err)
+	}
+	return nil
+}
+
+// newWebhookSecret generates a random secret for signing deliveries
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// loadWebhookOrg resolves the organization for a webhook route. Webhooks are managed by
+// owners without read-only access, signed in as themselves rather than through an API key,
+// and exist only while the webhooks feature is enabled.
+func (h *OrganizationHandler) loadWebhookOrg(c echo.Context, ctx context.Context, userID uuid.UUID) (*ent.Organization, error) {
+	if err := refuseAPIKey(c); err != nil {
+		return nil, err
+	}
+
+	org, membership, err := loadOrgMembership(ctx, h.client, userID, c.Param("slug"))
+	if err != nil {
+		return nil, err
+	}
+	if err := requireFeature(org, FeatureWebhooks); err != nil {
+		return nil, err
+	}
+	if !IsOwner(membership.Role) {
+		return nil, echo.NewHTTPError(http.StatusForbidden, "only owners can manage webhooks")
+	}
+	if err := requireWriteAccess(membership); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// getWebhook loads a webhook of the organization by the :webhook_id route parameter
+func (h *OrganizationHandler) getWebhook(ctx context.Context, org *ent.Organization, webhookIDStr string) (*ent.Webhook, error) {
+	webhookID, err := uuid.Parse(webhookIDStr)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid webhook ID")
+	}
+
+	wh, err := h.client.Webhook.Query().
+		Where(
+			webhook.IDEQ(webhookID),
+			webhook.OrganizationIDEQ(org.ID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "webhook not found")
+		}
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get webhook")
+	}
+	return wh, nil
+}
+
+// CreateWebhook registers a webhook. The response includes the signing secret, which is not shown again.
+func (h *OrganizationHandler) CreateWebhook(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	req.URL = strings.TrimSpace(req.URL)
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if err := validateWebhookEvents(req.Events); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkWebhookURL(ctx, req.URL); err != nil {
+		return err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to generate webhook secret")
+	}
+
+	create := h.client.Webhook.Create().
+		SetOrganizationID(org.ID).
+		SetURL(req.URL).
+		SetSecret(secret).
+		SetEvents(slices.Compact(slices.Sorted(slices.Values(req.Events))))
+	if req.Active != nil {
+		create.SetActive(*req.Active)
+	}
+
+	wh, err := create.Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create webhook")
+	}
+
+	resp := newWebhookResponse(wh)
+	resp.Secret = wh.Secret
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// ListWebhooks lists the organization's webhooks
+func (h *OrganizationHandler) ListWebhooks(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := h.client.Webhook.Query().
+		Where(webhook.OrganizationIDEQ(org.ID)).
+		Order(ent.Asc(webhook.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list webhooks")
+	}
+
+	result := make([]WebhookResponse, len(webhooks))
+	for i, wh := range webhooks {
+		result[i] = newWebhookResponse(wh)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetWebhook gets a webhook
+func (h *OrganizationHandler) GetWebhook(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	wh, err := h.getWebhook(ctx, org, c.Param("webhook_id"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newWebhookResponse(wh))
+}
+
+// UpdateWebhook changes a webhook's URL, events or active state
+func (h *OrganizationHandler) UpdateWebhook(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+	if req.URL != nil {
+		trimmed := strings.TrimSpace(*req.URL)
+		req.URL = &trimmed
+	}
+
+	if err := orgValidate.Struct(req); err != nil {
+		return validationError(err)
+	}
+	if req.Events != nil {
+		if err := validateWebhookEvents(req.Events); err != nil {
+			return err
+		}
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	wh, err := h.getWebhook(ctx, org, c.Param("webhook_id"))
+	if err != nil {
+		return err
+	}
+	if req.URL != nil {
+		if err := checkWebhookURL(ctx, *req.URL); err != nil {
+			return err
+		}
+	}
+
+	update := h.client.Webhook.UpdateOne(wh)
+	if req.URL != nil {
+		update.SetURL(*req.URL)
+	}
+	if req.Events != nil {
+		update.SetEvents(slices.Compact(slices.Sorted(slices.Values(req.Events))))
+	}
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+
+	wh, err = update.Save(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update webhook")
+	}
+
+	return c.JSON(http.StatusOK, newWebhookResponse(wh))
+}
+
+// DeleteWebhook deletes a webhook and its delivery history
+func (h *OrganizationHandler) DeleteWebhook(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	wh, err := h.getWebhook(ctx, org, c.Param("webhook_id"))
+	if err != nil {
+		return err
+	}
+
+	err = withTx(ctx, h.client, func(tx *ent.Tx) error {
+		if _, err := tx.WebhookDelivery.Delete().
+			Where(webhookdelivery.WebhookIDEQ(wh.ID)).
+			Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete webhook deliveries").SetInternal(err)
+		}
+		if err := tx.Webhook.DeleteOne(wh).Exec(ctx); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete webhook").SetInternal(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries lists a webhook's most recent deliveries, newest first
+func (h *OrganizationHandler) ListWebhookDeliveries(c echo.Context) error {
+	userID, ok := auth.GetUserID(c)
+	if !ok {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	limit, err := parseLimit(c, 50, 100)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	org, err := h.loadWebhookOrg(c, ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	wh, err := h.getWebhook(ctx, org, c.Param("webhook_id"))
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := h.client.WebhookDelivery.Query().
+		Where(webhookdelivery.WebhookIDEQ(wh.ID)).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to list webhook deliveries")
+	}
+
+	result := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = WebhookDeliveryResponse{
+			ID:          d.ID,
+			Event:       d.Event,
+			Status:      string(d.Status),
+			Attempts:    d.Attempts,
+			StatusCode:  d.StatusCode,
+			LastError:   d.LastError,
+			DeliveredAt: d.DeliveredAt,
+			CreatedAt:   d.CreatedAt,
+		}
+		// Only pending deliveries have another attempt coming
+		if d.Status == webhookdelivery.StatusPending {
+			result[i].NextAttemptAt = &d.NextAttemptAt
+		}
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// queueWebhookDeliveries queues a delivery of an activity to each active webhook of its
+// organization subscribed to the activity's action. Nothing is queued while the organization
+// has the webhooks feature disabled.
+func queueWebhookDeliveries(ctx context.Context, client *ent.Client, a *ent.Activity) {
+	logger := logging.FromContext(ctx)
+
+	org, err := client.Organization.Get(ctx, a.OrganizationID)
+	if err != nil {
+		logger.Error("failed to load organization for webhooks", "organization_id", a.OrganizationID, "activity_id", a.ID, "error", err)
+		return
+	}
+	if !FeatureEnabled(org, FeatureWebhooks) {
+		return
+	}
+
+	webhooks, err := client.Webhook.Query().
+		Where(
+			webhook.OrganizationIDEQ(a.OrganizationID),
+			webhook.Active(true),
+		).
+		All(ctx)
+	if err != nil {
+		logger.Error("failed to load webhooks", "organization_id", a.OrganizationID, "activity_id", a.ID, "error", err)
+		return
+	}
+
+	var payload []byte
+	for _, wh := range webhooks {
+		if !slices.Contains(wh.Events, a.Action) {
+			continue
+		}
+		if payload == nil {
+			payload, err = json.Marshal(webhookPayload{
+				ID:             a.ID,
+				Event:          a.Action,
+				OrganizationID: a.OrganizationID,
+				ActorID:        a.ActorID,
+				TargetType:     a.TargetType,
+				TargetID:       a.TargetID,
+				Data:           a.Metadata,
+				CreatedAt:      a.CreatedAt,
+			})
+			if err != nil {
+				logger.Error("failed to encode webhook payload", "action", a.Action, "activity_id", a.ID, "error", err)
+				return
+			}
+		}
+
+		_, err := client.WebhookDelivery.Create().
+			SetWebhookID(wh.ID).
+			SetEvent(a.Action).
+			SetPayload(string(payload)).
+			Save(ctx)
+		if err != nil {
+			logger.Error("failed to queue webhook delivery", "action", a.Action, "activity_id", a.ID, "webhook_id", wh.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/ent"
+	"backend/ent/organizationmember"
+	"backend/internal/service"
+)
+
+// newTestWebhookOrg creates an organization with webhooks enabled and returns it with a handler
+// and its owner
+func newTestWebhookOrg(t *testing.T) (*OrganizationHandler, *ent.Client, *ent.Organization, *ent.User) {
+	t.Helper()
+	client := newTestClient(t)
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owner := createTestUser(t, client, "owner@example.com")
+	org := createTestOrg(t, client, "acme", owner)
+	org, err = org.Update().
+		SetFeatureFlags(map[string]bool{FeatureWebhooks: true}).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewOrganizationHandler(client, emailService), client, org, owner
+}
+
+func TestCreateWebhookRejectsPrivateAddresses(t *testing.T) {
+	h, _, org, owner := newTestWebhookOrg(t)
+
+	tests := []struct {
+		url  string
+		want int
+	}{
+		{"http://127.0.0.1/hook", http.StatusBadRequest},
+		{"http://localhost:8080/hook", http.StatusBadRequest},
+		{"http://10.1.2.3/hook", http.StatusBadRequest},
+		{"http://192.168.0.10/hook", http.StatusBadRequest},
+		{"http://169.254.169.254/latest/meta-data", http.StatusBadRequest},
+		{"http://[::1]/hook", http.StatusBadRequest},
+		{"http://[fd00::1]/hook", http.StatusBadRequest},
+		{"http://[::ffff:127.0.0.1]/hook", http.StatusBadRequest},
+		{"http://0.0.0.0/hook", http.StatusBadRequest},
+		{"https://203.0.114.10/hook", http.StatusCreated},
+	}
+	for _, tt := range tests {
+		c, rec := newTestContext(t, testRequest{
+			Method: http.MethodPost,
+			Params: map[string]string{"slug": org.Slug},
+			Body:   CreateWebhookRequest{URL: tt.url, Events: []string{ActivityTaskCreated}},
+			UserID: owner.ID,
+		})
+		if status := statusOf(t, h.CreateWebhook(c), rec); status != tt.want {
+			t.Errorf("%s: status %d, want %d", tt.url, status, tt.want)
+		}
+	}
+}
+
+func TestUpdateWebhookRejectsPrivateAddresses(t *testing.T) {
+	h, client, org, owner := newTestWebhookOrg(t)
+	wh, err := client.Webhook.Create().
+		SetOrganizationID(org.ID).
+		SetURL("https://203.0.114.10/hook").
+		SetSecret("secret").
+		SetEvents([]string{ActivityTaskCreated}).
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	url := "http://10.0.0.1/hook"
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPatch,
+		Params: map[string]string{"slug": org.Slug, "webhook_id": wh.ID.String()},
+		Body:   UpdateWebhookRequest{URL: &url},
+		UserID: owner.ID,
+	})
+	if status := statusOf(t, h.UpdateWebhook(c), rec); status != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", status, http.StatusBadRequest)
+	}
+}
+
+func TestWebhooksRequireWriteAccess(t *testing.T) {
+	h, client, org, _ := newTestWebhookOrg(t)
+	readOnly := createTestUser(t, client, "readonly@example.com")
+	m := addTestMember(t, client, org, readOnly, organizationmember.RoleOwner)
+	if err := m.Update().SetReadOnly(true).Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c, rec := newTestContext(t, testRequest{
+		Method: http.MethodPost,
+		Params: map[string]string{"slug": org.Slug},
+		Body:   CreateWebhookRequest{URL: "https://203.0.114.10/hook", Events: []string{ActivityTaskCreated}},
+		UserID: readOnly.ID,
+	})
+	if status := statusOf(t, h.CreateWebhook(c), rec); status != http.StatusForbidden {
+		t.Errorf("status %d, want %d", status, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,115 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDHeader carries the request id in both directions
+const RequestIDHeader = echo.HeaderXRequestID
+
+// maxRequestIDLength caps request ids accepted from clients
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// NewLogger creates a logger that writes JSON lines to stdout
+func NewLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stdout, nil))
+}
+
+// WithRequestID returns a copy of ctx carrying the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the id of the request ctx belongs to, or "" outside a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// FromContext returns the default logger, tagged with the request id when ctx has one
+func FromContext(ctx context.Context) *slog.Logger {
+	if id := RequestID(ctx); id != "" {
+		return slog.Default().With("request_id", id)
+	}
+	return slog.Default()
+}
+
+// validRequestID reports whether a client-supplied request id is safe to reuse: short, and
+// made only of characters that can't break a log line or a header
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// Middleware logs each request as one JSON line with its method, path, status, latency and,
+// once authenticated, user id. Each request gets an id, taken from the X-Request-ID header
+// when the client sent a valid one and generated otherwise; it is echoed in the response
+// header and stored in the request context for handlers' logs.
+func Middleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			req := c.Request()
+			id := req.Header.Get(RequestIDHeader)
+			if !validRequestID(id) {
+				id = uuid.NewString()
+			}
+			c.Response().Header().Set(RequestIDHeader, id)
+			c.SetRequest(req.WithContext(WithRequestID(req.Context(), id)))
+
+			// Let the error handler write the response first, so its status is the one logged
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			status := c.Response().Status
+			attrs := []slog.Attr{
+				slog.String("request_id", id),
+				slog.String("method", req.Method),
+				slog.String("path", req.URL.Path),
+				slog.String("route", c.Path()),
+				slog.Int("status", status),
+				slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+				slog.String("remote_ip", c.RealIP()),
+			}
+			if userID, ok := auth.GetUserID(c); ok {
+				attrs = append(attrs, slog.String("user_id", userID.String()))
+			}
+			// Server errors are logged in full by the error handler; client errors are noted here
+			var he *echo.HTTPError
+			if errors.As(err, &he) && he.Code < http.StatusInternalServerError {
+				attrs = append(attrs, slog.String("error", fmt.Sprint(he.Message)))
+			}
+
+			level := slog.LevelInfo
+			if status >= http.StatusInternalServerError {
+				level = slog.LevelError
+			}
+			logger.LogAttrs(c.Request().Context(), level, "request", attrs...)
+
+			return nil
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AttemptStore counts attempts per key in fixed windows that start with the first attempt.
+// The in-memory store suits a single instance; a shared store such as Redis can implement
+// the same interface when the API runs on several instances.
+type AttemptStore interface {
+	// Count returns the attempts recorded for key in its current window and when it ends
+	Count(ctx context.Context, key string, now time.Time) (count int, resetAt time.Time, err error)
+	// Add records an attempt for key, starting a window of the given length if none is open
+	Add(ctx context.Context, key string, now time.Time, window time.Duration) error
+	// Reset forgets the attempts recorded for key
+	Reset(ctx context.Context, key string) error
+}
+
+// MemoryAttemptStore is an AttemptStore kept in process memory
+type MemoryAttemptStore struct {
+	mu        sync.Mutex
+	entries   map[string]*quotaEntry
+	lastSweep time.Time
+}
+
+// quotaEntry counts attempts in a window ending at resetAt
+type quotaEntry struct {
+	count   int
+	resetAt time.Time
+}
+
+// NewMemoryAttemptStore creates an empty in-memory attempt store
+func NewMemoryAttemptStore() *MemoryAttemptStore {
+	return &MemoryAttemptStore{
+		entries: make(map[string]*quotaEntry),
+	}
+}
+
+// Count implements AttemptStore
+func (s *MemoryAttemptStore) Count(_ context.Context, key string, now time.Time) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok || !now.Before(entry.resetAt) {
+		return 0, time.Time{}, nil
+	}
+	return entry.count, entry.resetAt, nil
+}
+
+// Add implements AttemptStore
+func (s *MemoryAttemptStore) Add(_ context.Context, key string, now time.Time, window time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	entry, ok := s.entries[key]
+	if !ok || !now.Before(entry.resetAt) {
+		entry = &quotaEntry{resetAt: now.Add(window)}
+		s.entries[key] = entry
+	}
+	entry.count++
+	return nil
+}
+
+// Reset implements AttemptStore
+func (s *MemoryAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep drops expired entries at most once a minute so the map doesn't grow unbounded
+func (s *MemoryAttemptStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	for key, entry := range s.entries {
+		if !now.Before(entry.resetAt) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// AttemptLimiter blocks a key once limit attempts were recorded for it within window
+type AttemptLimiter struct {
+	store  AttemptStore
+	limit  int
+	window time.Duration
+}
+
+// NewAttemptLimiter creates a limiter allowing limit attempts per key per window (0 means unlimited)
+func NewAttemptLimiter(store AttemptStore, limit int, window time.Duration) *AttemptLimiter {
+	return &AttemptLimiter{
+		store:  store,
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Check reports how long to wait before another attempt is allowed for all of keys;
+// zero means the attempt may proceed
+func (l *AttemptLimiter) Check(ctx context.Context, now time.Time, keys ...string) (time.Duration, error) {
+	if l == nil || l.limit <= 0 {
+		return 0, nil
+	}
+
+	var wait time.Duration
+	for _, key := range keys {
+		count, resetAt, err := l.store.Count(ctx, key, now)
+		if err != nil {
+			return 0, err
+		}
+		if count >= l.limit && resetAt.Sub(now) > wait {
+			wait = resetAt.Sub(now)
+		}
+	}
+	return wait, nil
+}
+
+// Record counts an attempt against each of keys
+func (l *AttemptLimiter) Record(ctx context.Context, now time.Time, keys ...string) error {
+	if l == nil || l.limit <= 0 {
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := l.store.Add(ctx, key, now, l.window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reset clears the attempts recorded for each of keys
+func (l *AttemptLimiter) Reset(ctx context.Context, keys ...string) error {
+	if l == nil || l.limit <= 0 {
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := l.store.Reset(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TooManyAttempts builds the 429 response for a blocked attempt, telling the client when to retry
+func TooManyAttempts(c echo.Context, wait time.Duration, message string) error {
+	retryAfter := int(wait.Seconds()) + 1
+	c.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	return echo.NewHTTPError(http.StatusTooManyRequests, message)
+}
@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// quotaWindow is the length of a user's quota window
+const quotaWindow = 24 * time.Hour
+
+// quotaBucket is the granularity the window slides at
+const quotaBucket = time.Hour
+
+// quotaBuckets is the number of buckets in a window
+const quotaBuckets = int(quotaWindow / quotaBucket)
+
+// UserQuota enforces a per-user request quota over a sliding 24-hour window.
+// Requests are counted in hourly buckets and a request is allowed while the buckets of the
+// last 24 hours add up to less than the limit, so a window boundary can't be used to make
+// twice the quota in a row; each hour's requests free up again 24 hours later.
+type UserQuota struct {
+	limit     int
+	mu        sync.Mutex
+	entries   map[uuid.UUID]*userQuotaEntry
+	lastSweep time.Time
+	now       func() time.Time // the middleware's clock, replaced in tests
+}
+
+// userQuotaEntry is a ring of hourly request counts, slot i holding the hour starting at
+// starts[i]
+type userQuotaEntry struct {
+	counts [quotaBuckets]int
+	starts [quotaBuckets]time.Time
+}
+
+// NewUserQuota creates a quota allowing limit requests per user per window (0 means unlimited)
+func NewUserQuota(limit int) *UserQuota {
+	return &UserQuota{
+		limit:   limit,
+		entries: make(map[uuid.UUID]*userQuotaEntry),
+		now:     time.Now,
+	}
+}
+
+// Take records a request for the user and reports whether it is within the quota,
+// along with the remaining request count and when the oldest counted requests leave the
+// window, freeing up quota again
+func (q *UserQuota) Take(userID uuid.UUID, now time.Time) (allowed bool, remaining int, resetAt time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.sweep(now)
+
+	entry, ok := q.entries[userID]
+	if !ok {
+		entry = &userQuotaEntry{}
+		q.entries[userID] = entry
+	}
+
+	current := now.Truncate(quotaBucket)
+	windowStart := current.Add(-quotaWindow)
+	used := 0
+	oldest := current
+	for i, start := range entry.starts {
+		if entry.counts[i] == 0 || !start.After(windowStart) {
+			continue
+		}
+		used += entry.counts[i]
+		if start.Before(oldest) {
+			oldest = start
+		}
+	}
+	resetAt = oldest.Add(quotaWindow)
+
+	if used >= q.limit {
+		return false, 0, resetAt
+	}
+
+	slot := int(current.Unix()/int64(quotaBucket/time.Second)) % quotaBuckets
+	if !entry.starts[slot].Equal(current) {
+		entry.starts[slot] = current
+		entry.counts[slot] = 0
+	}
+	entry.counts[slot]++
+	return true, q.limit - used - 1, resetAt
+}
+
+// sweep drops users without requests in the window at most once an hour so the map doesn't
+// grow unbounded
+func (q *UserQuota) sweep(now time.Time) {
+	if now.Sub(q.lastSweep) < time.Hour {
+		return
+	}
+	windowStart := now.Truncate(quotaBucket).Add(-quotaWindow)
+	for id, entry := range q.entries {
+		active := false
+		for i, start := range entry.starts {
+			if entry.counts[i] > 0 && start.After(windowStart) {
+				active = true
+				break
+			}
+		}
+		if !active {
+			delete(q.entries, id)
+		}
+	}
+	q.lastSweep = now
+}
+
+// UserQuotaMiddleware enforces the quota for authenticated requests.
+// It must run after auth.AuthMiddleware; requests without a user are passed through.
+func UserQuotaMiddleware(q *UserQuota) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if q == nil || q.limit <= 0 {
+				return next(c)
+			}
+
+			userID, ok := auth.GetUserID(c)
+			if !ok {
+				return next(c)
+			}
+
+			now := q.now()
+			allowed, remaining, resetAt := q.Take(userID, now)
+
+			header := c.Response().Header()
+			header.Set("X-RateLimit-Limit", strconv.Itoa(q.limit))
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+			header.Set("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+
+			if !allowed {
+				retryAfter := int(resetAt.Sub(now).Seconds()) + 1
+				header.Set("Retry-After", strconv.Itoa(retryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "daily request quota exceeded")
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/internal/auth"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func TestUserQuotaTake(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	nextDay := func(hour int) time.Time { return time.Date(2026, 1, 2, hour, 0, 0, 0, time.UTC) }
+
+	q := NewUserQuota(2)
+	user := uuid.New()
+	tests := []struct {
+		name          string
+		at            time.Time
+		wantAllowed   bool
+		wantRemaining int
+		wantReset     time.Time
+	}{
+		{"first request", start, true, 1, nextDay(10)},
+		{"an hour later", start.Add(time.Hour), true, 0, nextDay(10)},
+		{"over the limit", start.Add(2 * time.Hour), false, 0, nextDay(10)},
+		{"just before the first hour leaves the window", nextDay(10).Add(-time.Nanosecond), false, 0, nextDay(10)},
+		{"first hour left the window", nextDay(10), true, 0, nextDay(11)},
+		{"over the limit again", nextDay(10).Add(time.Minute), false, 0, nextDay(11)},
+		{"second hour left the window", nextDay(11), true, 0, nextDay(10).Add(24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		allowed, remaining, resetAt := q.Take(user, tt.at)
+		if allowed != tt.wantAllowed || remaining != tt.wantRemaining || !resetAt.Equal(tt.wantReset) {
+			t.Errorf("%s: Take = %v, %d, %s, want %v, %d, %s", tt.name, allowed, remaining, resetAt, tt.wantAllowed, tt.wantRemaining, tt.wantReset)
+		}
+	}
+
+	// Other users have their own quota
+	if allowed, remaining, _ := q.Take(uuid.New(), nextDay(11)); !allowed || remaining != 1 {
+		t.Errorf("another user: Take = %v, %d, want true, 1", allowed, remaining)
+	}
+}
+
+func TestUserQuotaMiddleware(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	user := uuid.New()
+
+	tests := []struct {
+		name       string
+		limit      int
+		nilQuota   bool
+		anonymous  bool
+		requests   int
+		wantStatus int
+		wantHeader map[string]string
+	}{
+		{
+			name: "within the quota", limit: 2, requests: 2, wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"X-RateLimit-Limit": "2", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Truncate(time.Hour).Add(24*time.Hour).Unix(), 10)},
+		},
+		{
+			name: "over the quota", limit: 2, requests: 3, wantStatus: http.StatusTooManyRequests,
+			wantHeader: map[string]string{
+				"X-RateLimit-Remaining": "0",
+				"X-RateLimit-Reset":     strconv.FormatInt(now.Truncate(time.Hour).Add(24*time.Hour).Unix(), 10),
+				"Retry-After":           strconv.Itoa(int((23*time.Hour + 30*time.Minute).Seconds()) + 1),
+			},
+		},
+		{
+			name: "unlimited by default", limit: 0, requests: 1000, wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"X-RateLimit-Limit": "", "X-RateLimit-Remaining": ""},
+		},
+		{
+			name: "no quota", nilQuota: true, requests: 3, wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"X-RateLimit-Limit": ""},
+		},
+		{
+			name: "anonymous requests", limit: 1, anonymous: true, requests: 3, wantStatus: http.StatusOK,
+			wantHeader: map[string]string{"X-RateLimit-Limit": ""},
+		},
+	}
+	for _, tt := range tests {
+		var q *UserQuota
+		if !tt.nilQuota {
+			q = NewUserQuota(tt.limit)
+			q.now = func() time.Time { return now }
+		}
+		h := UserQuotaMiddleware(q)(func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+		e := echo.New()
+		var status int
+		var header http.Header
+		for range tt.requests {
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			if !tt.anonymous {
+				c.Set(auth.UserIDKey, user)
+			}
+			err := h(c)
+			status = rec.Code
+			var he *echo.HTTPError
+			if errors.As(err, &he) {
+				status = he.Code
+			} else if err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			header = rec.Header()
+		}
+
+		if status != tt.wantStatus {
+			t.Errorf("%s: status %d, want %d", tt.name, status, tt.wantStatus)
+		}
+		for name, want := range tt.wantHeader {
+			if got := header.Get(name); got != want {
+				t.Errorf("%s: %s %q, want %q", tt.name, name, got, want)
+			}
+		}
+	}
+}
@@ -2,10 +2,17 @@ package service
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/smtp"
+	"net/textproto"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"backend/ent"
 
 	"github.com/resend/resend-go/v2"
 )
@@ -15,20 +22,28 @@ type EmailSender interface {
 	Send(to, subject, html, text string) error
 }
 
-// SMTPSender sends emails via SMTP (for development with Mailpit)
+// SMTPSender sends emails via SMTP (Mailpit in development, or a real SMTP provider)
 type SMTPSender struct {
 	host      string
 	port      string
 	fromEmail string
+	auth      smtp.Auth // nil sends without authentication
+	startTLS  bool      // require STARTTLS before anything else is sent
 }
 
-// NewSMTPSender creates a new SMTP sender
-func NewSMTPSender(host, port, fromEmail string) *SMTPSender {
-	return &SMTPSender{
+// NewSMTPSender creates a new SMTP sender. Credentials are optional: with an empty user the
+// sender connects without authentication, as Mailpit expects.
+func NewSMTPSender(host, port, fromEmail, user, password string, startTLS bool) *SMTPSender {
+	s := &SMTPSender{
 		host:      host,
 		port:      port,
 		fromEmail: fromEmail,
+		startTLS:  startTLS,
+	}
+	if user != "" {
+		s.auth = smtp.PlainAuth("", user, password, host)
 	}
+	return s
 }
 
 // Send sends an email via SMTP
@@ -53,8 +68,51 @@ func (s *SMTPSender) Send(to, subject, html, text string) error {
 		s.fromEmail, to, subject, html,
 	))
 
-	// Send without authentication (Mailpit doesn't require it)
-	return smtp.SendMail(addr, nil, from, []string{to}, msg)
+	if !s.startTLS {
+		// smtp.SendMail upgrades to TLS when the server offers it; PlainAuth refuses to send
+		// credentials over an unencrypted connection to anything but localhost
+		return smtp.SendMail(addr, s.auth, from, []string{to}, msg)
+	}
+	return s.sendStartTLS(addr, from, to, msg)
+}
+
+// sendStartTLS sends a message over a connection that must be upgraded with STARTTLS
+func (s *SMTPSender) sendStartTLS(addr, from, to string, msg []byte) error {
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); !ok {
+		return errors.New("smtp: server does not support STARTTLS")
+	}
+	if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+		return err
+	}
+	if s.auth != nil {
+		if err := c.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
 }
 
 // ResendSender sends emails via Resend API (for production)
@@ -95,14 +153,19 @@ func (s *NoopSender) Send(to, subject, html, text string) error {
 	return nil
 }
 
-// EmailService handles email sending operations
+// EmailService handles email sending operations. Emails are queued as EmailJob rows and
+// delivered by RunWorker, so they survive restarts and failed deliveries stay visible.
 type EmailService struct {
-	sender EmailSender
-	appURL string
+	client     *ent.Client
+	sender     EmailSender
+	templates  emailTemplates
+	appURL     string
+	maxRetries int           // retries after the first failed attempt
+	retryDelay time.Duration // delay before the first retry, doubled for each further one
 }
 
 // NewEmailService creates a new email service with the appropriate sender strategy
-func NewEmailService() *EmailService {
+func NewEmailService(client *ent.Client) (*EmailService, error) {
 	fromEmail := os.Getenv("EMAIL_FROM")
 	if fromEmail == "" {
 		fromEmail = "Team Todo <noreply@example.com>"
@@ -113,13 +176,44 @@ func NewEmailService() *EmailService {
 		appURL = "http://localhost:3000"
 	}
 
+	maxRetries := 3
+	if v, err := strconv.Atoi(os.Getenv("EMAIL_SEND_RETRIES")); err == nil && v >= 0 {
+		maxRetries = v
+	}
+
+	templates, err := loadEmailTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("loading email templates: %w", err)
+	}
+
 	// Select the appropriate sender strategy
 	sender := createSender(fromEmail)
 
 	return &EmailService{
-		sender: sender,
-		appURL: appURL,
+		client:     client,
+		sender:     sender,
+		templates:  templates,
+		appURL:     appURL,
+		maxRetries: maxRetries,
+		retryDelay: 30 * time.Second,
+	}, nil
+}
+
+// send queues an email for the background worker, which retries transient failures
+// with exponential backoff
+func (s *EmailService) send(ctx context.Context, to, subject, html, text string) error {
+	return s.enqueue(ctx, to, subject, html, text)
+}
+
+// isTransientSendError reports whether a failed send may succeed when retried.
+// SMTP 5xx replies are permanent rejections; anything else (network errors, 4xx replies,
+// API errors) is worth another try.
+func isTransientSendError(err error) bool {
+	var smtpErr *textproto.Error
+	if errors.As(err, &smtpErr) {
+		return smtpErr.Code < 500
 	}
+	return true
 }
 
 // createSender creates the appropriate EmailSender based on environment variables
@@ -131,8 +225,14 @@ func createSender(fromEmail string) EmailSender {
 		if smtpPort == "" {
 			smtpPort = "1025"
 		}
-		fmt.Println("[EmailService] Using SMTP sender (Mailpit)")
-		return NewSMTPSender(smtpHost, smtpPort, fromEmail)
+		smtpUser := os.Getenv("SMTP_USER")
+		startTLS := os.Getenv("SMTP_TLS") == "true"
+		if smtpUser != "" {
+			fmt.Println("[EmailService] Using SMTP sender with authentication")
+		} else {
+			fmt.Println("[EmailService] Using SMTP sender (Mailpit)")
+		}
+		return NewSMTPSender(smtpHost, smtpPort, fromEmail, smtpUser, os.Getenv("SMTP_PASSWORD"), startTLS)
 	}
 
 	// Strategy 2: Resend API (for production)
@@ -147,101 +247,65 @@ func createSender(fromEmail string) EmailSender {
 	return &NoopSender{}
 }
 
+// sendTemplate renders an email template in the given locale and queues it
+func (s *EmailService) sendTemplate(ctx context.Context, locale, name, toEmail string, data map[string]any) error {
+	subject, html, text, err := s.templates.render(locale, name, data)
+	if err != nil {
+		return err
+	}
+	return s.send(ctx, toEmail, subject, html, text)
+}
+
 // SendInviteEmail sends an invitation email to join an organization
-func (s *EmailService) SendInviteEmail(ctx context.Context, toEmail, inviterName, orgName, token string) error {
-	inviteURL := fmt.Sprintf("%s/invite/%s", s.appURL, token)
-
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Team Todoへの招待</title>
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; border-radius: 10px 10px 0 0;">
-        <h1 style="color: white; margin: 0; font-size: 24px;">Team Todo</h1>
-    </div>
-    <div style="background: #f9f9f9; padding: 30px; border-radius: 0 0 10px 10px;">
-        <h2 style="color: #333; margin-top: 0;">%s さんから招待が届いています</h2>
-        <p>%s から「<strong>%s</strong>」への参加招待が届きました。</p>
-        <p>以下のボタンをクリックして参加してください：</p>
-        <div style="text-align: center; margin: 30px 0;">
-            <a href="%s" style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 15px 30px; text-decoration: none; border-radius: 5px; font-weight: bold; display: inline-block;">招待を承認する</a>
-        </div>
-        <p style="color: #666; font-size: 14px;">このリンクは7日間有効です。</p>
-        <hr style="border: none; border-top: 1px solid #ddd; margin: 20px 0;">
-        <p style="color: #999; font-size: 12px;">
-            このメールに心当たりがない場合は、無視していただいて構いません。<br>
-            リンクが機能しない場合は、以下のURLをブラウザに貼り付けてください：<br>
-            <a href="%s" style="color: #667eea;">%s</a>
-        </p>
-    </div>
-</body>
-</html>
-`, inviterName, inviterName, orgName, inviteURL, inviteURL, inviteURL)
-
-	text := fmt.Sprintf(`
-%s さんから招待が届いています
-
-%s から「%s」への参加招待が届きました。
-
-以下のリンクをクリックして参加してください：
-%s
-
-このリンクは7日間有効です。
-
-このメールに心当たりがない場合は、無視していただいて構いません。
-`, inviterName, inviterName, orgName, inviteURL)
-
-	subject := fmt.Sprintf("[Team Todo] %s から「%s」への招待", inviterName, orgName)
-	return s.sender.Send(toEmail, subject, html, text)
+func (s *EmailService) SendInviteEmail(ctx context.Context, locale, toEmail, inviterName, orgName, token string) error {
+	return s.sendTemplate(ctx, locale, "invite", toEmail, map[string]any{
+		"InviterName": inviterName,
+		"OrgName":     orgName,
+		"InviteURL":   fmt.Sprintf("%s/invite/%s", s.appURL, token),
+	})
 }
 
 // SendWelcomeEmail sends a welcome email to new users
-func (s *EmailService) SendWelcomeEmail(ctx context.Context, toEmail, displayName string) error {
-	loginURL := fmt.Sprintf("%s/login", s.appURL)
-
-	html := fmt.Sprintf(`
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Team Todoへようこそ</title>
-</head>
-<body style="font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; max-width: 600px; margin: 0 auto; padding: 20px;">
-    <div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 30px; border-radius: 10px 10px 0 0;">
-        <h1 style="color: white; margin: 0; font-size: 24px;">Team Todo</h1>
-    </div>
-    <div style="background: #f9f9f9; padding: 30px; border-radius: 0 0 10px 10px;">
-        <h2 style="color: #333; margin-top: 0;">%s さん、ようこそ！</h2>
-        <p>Team Todoへのご登録ありがとうございます。</p>
-        <p>チームのタスク管理を効率的に行うために、Team Todoをご活用ください。</p>
-        <div style="text-align: center; margin: 30px 0;">
-            <a href="%s" style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 15px 30px; text-decoration: none; border-radius: 5px; font-weight: bold; display: inline-block;">ログインする</a>
-        </div>
-        <hr style="border: none; border-top: 1px solid #ddd; margin: 20px 0;">
-        <p style="color: #999; font-size: 12px;">
-            ご不明な点がございましたら、お気軽にお問い合わせください。
-        </p>
-    </div>
-</body>
-</html>
-`, displayName, loginURL)
-
-	text := fmt.Sprintf(`
-%s さん、ようこそ！
-
-Team Todoへのご登録ありがとうございます。
-チームのタスク管理を効率的に行うために、Team Todoをご活用ください。
-
-ログインはこちらから：
-%s
-
-ご不明な点がございましたら、お気軽にお問い合わせください。
-`, displayName, loginURL)
-
-	return s.sender.Send(toEmail, "[Team Todo] ご登録ありがとうございます", html, text)
+func (s *EmailService) SendWelcomeEmail(ctx context.Context, locale, toEmail, displayName string) error {
+	return s.sendTemplate(ctx, locale, "welcome", toEmail, map[string]any{
+		"DisplayName": displayName,
+		"LoginURL":    fmt.Sprintf("%s/login", s.appURL),
+	})
+}
+
+// SendAddedToOrganizationEmail notifies an existing user that they were added to an organization
+func (s *EmailService) SendAddedToOrganizationEmail(ctx context.Context, locale, toEmail, inviterName, orgName, orgSlug string) error {
+	return s.sendTemplate(ctx, locale, "added_to_organization", toEmail, map[string]any{
+		"InviterName": inviterName,
+		"OrgName":     orgName,
+		"OrgURL":      fmt.Sprintf("%s/org/%s", s.appURL, orgSlug),
+	})
+}
+
+// SendAccountDeletedEmail confirms to a user that their account has been deleted
+func (s *EmailService) SendAccountDeletedEmail(ctx context.Context, locale, toEmail, displayName string) error {
+	return s.sendTemplate(ctx, locale, "account_deleted", toEmail, map[string]any{
+		"DisplayName": displayName,
+	})
+}
+
+// SendTaskUpdatedEmail notifies a watcher that a task they follow was changed
+func (s *EmailService) SendTaskUpdatedEmail(ctx context.Context, locale, toEmail, actorName, taskTitle, projectName, orgSlug, projectID string) error {
+	return s.sendTemplate(ctx, locale, "task_updated", toEmail, map[string]any{
+		"ActorName":   actorName,
+		"TaskTitle":   taskTitle,
+		"ProjectName": projectName,
+		"ProjectURL":  fmt.Sprintf("%s/org/%s/projects/%s", s.appURL, orgSlug, projectID),
+	})
+}
+
+// SendTaskCommentedEmail notifies a watcher of a new comment on a task they follow
+func (s *EmailService) SendTaskCommentedEmail(ctx context.Context, locale, toEmail, actorName, taskTitle, projectName, orgSlug, projectID, comment string) error {
+	return s.sendTemplate(ctx, locale, "task_commented", toEmail, map[string]any{
+		"ActorName":   actorName,
+		"TaskTitle":   taskTitle,
+		"ProjectName": projectName,
+		"ProjectURL":  fmt.Sprintf("%s/org/%s/projects/%s", s.appURL, orgSlug, projectID),
+		"Comment":     comment,
+	})
 }
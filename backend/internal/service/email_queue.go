@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"backend/ent"
+	"backend/ent/emailjob"
+)
+
+const (
+	// emailPollInterval is how often the worker looks for due jobs
+	emailPollInterval = 5 * time.Second
+	// emailBatchSize caps the jobs handled per poll
+	emailBatchSize = 20
+	// emailStaleAfter is how long a job may stay "sending" before it is assumed to have been
+	// abandoned by a crashed worker and is made pending again
+	emailStaleAfter = 10 * time.Minute
+)
+
+// enqueue stores an email as a pending job for the worker to deliver
+func (s *EmailService) enqueue(ctx context.Context, to, subject, html, text string) error {
+	_, err := s.client.EmailJob.Create().
+		SetTo(to).
+		SetSubject(subject).
+		SetHTML(html).
+		SetText(text).
+		Save(ctx)
+	if err != nil {
+		log.Printf("[EmailService] Failed to queue %q to %s: %v", subject, to, err)
+	}
+	return err
+}
+
+// RunWorker delivers queued emails until ctx is cancelled
+func (s *EmailService) RunWorker(ctx context.Context) {
+	ticker := time.NewTicker(emailPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.requeueStale(ctx)
+		s.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// requeueStale makes jobs abandoned mid-send pending again
+func (s *EmailService) requeueStale(ctx context.Context) {
+	n, err := s.client.EmailJob.Update().
+		Where(
+			emailjob.StatusEQ(emailjob.StatusSending),
+			emailjob.UpdatedAtLT(time.Now().Add(-emailStaleAfter)),
+		).
+		SetStatus(emailjob.StatusPending).
+		Save(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[EmailService] Failed to requeue stale email jobs: %v", err)
+		}
+		return
+	}
+	if n > 0 {
+		log.Printf("[EmailService] Requeued %d stale email job(s)", n)
+	}
+}
+
+// deliverDue sends the pending jobs that are due
+func (s *EmailService) deliverDue(ctx context.Context) {
+	jobs, err := s.client.EmailJob.Query().
+		Where(
+			emailjob.StatusEQ(emailjob.StatusPending),
+			emailjob.NextAttemptAtLTE(time.Now()),
+		).
+		Order(ent.Asc(emailjob.FieldNextAttemptAt)).
+		Limit(emailBatchSize).
+		All(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[EmailService] Failed to load email jobs: %v", err)
+		}
+		return
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		s.deliver(ctx, job)
+	}
+}
+
+// deliver claims a job and sends it, recording the outcome. The claim is a conditional
+// update, so when several instances poll the same table each job is sent only once.
+func (s *EmailService) deliver(ctx context.Context, job *ent.EmailJob) {
+	claimed, err := s.client.EmailJob.Update().
+		Where(
+			emailjob.IDEQ(job.ID),
+			emailjob.StatusEQ(emailjob.StatusPending),
+		).
+		SetStatus(emailjob.StatusSending).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return
+	}
+	attempts := job.Attempts + 1
+
+	sendErr := s.sender.Send(job.To, job.Subject, job.HTML, job.Text)
+
+	update := s.client.EmailJob.UpdateOneID(job.ID)
+	switch {
+	case sendErr == nil:
+		update.SetStatus(emailjob.StatusSent).
+			SetSentAt(time.Now()).
+			ClearLastError()
+	case attempts > s.maxRetries || !isTransientSendError(sendErr):
+		log.Printf("[EmailService] Failed to send %q to %s after %d attempt(s): %v", job.Subject, job.To, attempts, sendErr)
+		update.SetStatus(emailjob.StatusFailed).
+			SetLastError(sendErr.Error())
+	default:
+		// Back off exponentially: retryDelay, then twice that, and so on
+		delay := s.retryDelay << (attempts - 1)
+		update.SetStatus(emailjob.StatusPending).
+			SetLastError(sendErr.Error()).
+			SetNextAttemptAt(time.Now().Add(delay))
+	}
+
+	// Record the outcome even if shutdown has begun, so a sent email is not sent again
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("[EmailService] Failed to update email job %s: %v", job.ID, err)
+	}
+}
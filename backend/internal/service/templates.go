@@ -0,0 +1,117 @@
+package service
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Supported email locales
+const (
+	LocaleJa      = "ja"
+	LocaleEn      = "en"
+	DefaultLocale = LocaleJa
+)
+
+var supportedLocales = []string{LocaleJa, LocaleEn}
+
+// emailTemplateNames lists the emails that have a template in every locale
+var emailTemplateNames = []string{"invite", "welcome", "added_to_organization", "account_deleted", "task_updated", "task_commented"}
+
+// templateFS holds the email templates: templates/<locale>/<name>.html renders inside
+// templates/layout.html, and templates/<locale>/<name>.txt defines the subject and plain-text body
+//
+//go:embed templates
+var templateFS embed.FS
+
+// emailTemplate is one email in one locale
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// emailTemplates maps locale and template name to the parsed templates
+type emailTemplates map[string]map[string]*emailTemplate
+
+// htmlFuncs are available to the HTML templates
+var htmlFuncs = htmltemplate.FuncMap{
+	// button passes a link and its label to the layout's "button" template
+	"button": func(url, label string) map[string]string {
+		return map[string]string{"URL": url, "Label": label}
+	},
+}
+
+// loadEmailTemplates parses every email template for every supported locale
+func loadEmailTemplates() (emailTemplates, error) {
+	templates := make(emailTemplates, len(supportedLocales))
+	for _, locale := range supportedLocales {
+		templates[locale] = make(map[string]*emailTemplate, len(emailTemplateNames))
+		for _, name := range emailTemplateNames {
+			base := fmt.Sprintf("templates/%s/%s", locale, name)
+
+			html, err := htmltemplate.New("layout.html").Funcs(htmlFuncs).
+				ParseFS(templateFS, "templates/layout.html", base+".html")
+			if err != nil {
+				return nil, err
+			}
+			text, err := texttemplate.ParseFS(templateFS, base+".txt")
+			if err != nil {
+				return nil, err
+			}
+
+			templates[locale][name] = &emailTemplate{html: html, text: text}
+		}
+	}
+	return templates, nil
+}
+
+// render renders an email in the given locale, falling back to the default locale
+func (t emailTemplates) render(locale, name string, data map[string]any) (subject, html, text string, err error) {
+	if _, ok := t[locale]; !ok {
+		locale = DefaultLocale
+	}
+	tmpl, ok := t[locale][name]
+	if !ok {
+		return "", "", "", fmt.Errorf("email template %q not found", name)
+	}
+
+	data["Locale"] = locale
+
+	var buf bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", "", err
+	}
+	subject = buf.String()
+
+	buf.Reset()
+	if err := tmpl.text.Execute(&buf, data); err != nil {
+		return "", "", "", err
+	}
+	text = buf.String()
+
+	buf.Reset()
+	if err := tmpl.html.Execute(&buf, data); err != nil {
+		return "", "", "", err
+	}
+	html = buf.String()
+
+	return subject, html, text, nil
+}
+
+// LocaleFromAcceptLanguage picks the first supported locale listed in an Accept-Language
+// header, or the default locale if none is
+func LocaleFromAcceptLanguage(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		for _, locale := range supportedLocales {
+			if primary == locale {
+				return locale
+			}
+		}
+	}
+	return DefaultLocale
+}
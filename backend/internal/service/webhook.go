@@ -0,0 +1,295 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+
+	"backend/ent"
+	"backend/ent/webhookdelivery"
+)
+
+const (
+	// webhookPollInterval is how often the worker looks for due deliveries
+	webhookPollInterval = 5 * time.Second
+	// webhookBatchSize caps the deliveries handled per poll
+	webhookBatchSize = 20
+	// webhookTimeout bounds a single POST to a webhook
+	webhookTimeout = 10 * time.Second
+	// webhookStaleAfter is how long a delivery may stay "sending" before it is assumed to have
+	// been abandoned by a crashed worker and is made pending again
+	webhookStaleAfter = 10 * time.Minute
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookSignatureHeader = "X-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// ErrPrivateWebhookAddress is returned for webhook URLs that point at a loopback, private or
+// link-local address, which would let an organization owner make the server call its own
+// internal network or a cloud metadata endpoint
+var ErrPrivateWebhookAddress = errors.New("webhook address is not a public address")
+
+// publicWebhookAddress reports whether webhooks may be sent to ip
+func publicWebhookAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast()
+}
+
+// CheckWebhookURL resolves the host of a webhook URL and returns ErrPrivateWebhookAddress when
+// any of its addresses is not public. It gives a helpful error early when a webhook
+// is saved; deliveries check the address actually dialed as well, since DNS can change.
+func CheckWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if ip, err := netip.ParseAddr(host); err == nil {
+		if !publicWebhookAddress(ip) {
+			return ErrPrivateWebhookAddress
+		}
+		return nil
+	}
+
+	ips, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return err
+	}
+	for _, ip := range ips {
+		if !publicWebhookAddress(ip) {
+			return ErrPrivateWebhookAddress
+		}
+	}
+	return nil
+}
+
+// dialPublicOnly is a net.Dialer Control function that refuses connections to non-public
+// addresses. It runs on the resolved address, so a hostname re-pointed at an internal address
+// after the webhook was saved is refused too.
+func dialPublicOnly(network, address string, _ syscall.RawConn) error {
+	addr, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !publicWebhookAddress(addr.Addr()) {
+		return ErrPrivateWebhookAddress
+	}
+	return nil
+}
+
+// WebhookService posts queued webhook deliveries to their endpoints
+type WebhookService struct {
+	client     *ent.Client
+	httpClient *http.Client
+	maxRetries int           // retries after the first failed attempt
+	retryDelay time.Duration // delay before the first retry, doubled for each further one
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(client *ent.Client) *WebhookService {
+	maxRetries := 5
+	if v, err := strconv.Atoi(os.Getenv("WEBHOOK_SEND_RETRIES")); err == nil && v >= 0 {
+		maxRetries = v
+	}
+
+	// Deliveries connect directly rather than through an HTTP proxy, so that the address check
+	// applies to the webhook itself
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout: webhookTimeout,
+		Control: dialPublicOnly,
+	}).DialContext
+
+	return &WebhookService{
+		client: client,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   webhookTimeout,
+			// A redirect could send the signed payload somewhere the owner didn't configure
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxRetries: maxRetries,
+		retryDelay: 30 * time.Second,
+	}
+}
+
+// SignWebhookPayload returns the X-Signature value for a payload: "sha256=" followed by the
+// hex-encoded HMAC-SHA256 of the body keyed with the webhook's secret
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// RunWorker delivers queued webhook payloads until ctx is cancelled
+func (s *WebhookService) RunWorker(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.requeueStale(ctx)
+		s.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// requeueStale makes deliveries abandoned mid-send pending again
+func (s *WebhookService) requeueStale(ctx context.Context) {
+	n, err := s.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusSending),
+			webhookdelivery.UpdatedAtLT(time.Now().Add(-webhookStaleAfter)),
+		).
+		SetStatus(webhookdelivery.StatusPending).
+		Save(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[WebhookService] Failed to requeue stale deliveries: %v", err)
+		}
+		return
+	}
+	if n > 0 {
+		log.Printf("[WebhookService] Requeued %d stale deliveries", n)
+	}
+}
+
+// deliverDue posts the pending deliveries that are due
+func (s *WebhookService) deliverDue(ctx context.Context) {
+	deliveries, err := s.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(webhookBatchSize).
+		All(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("[WebhookService] Failed to load deliveries: %v", err)
+		}
+		return
+	}
+
+	for _, d := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		s.deliver(ctx, d)
+	}
+}
+
+// deliver claims a delivery and posts it, recording the outcome. The claim is a conditional
+// update, so when several instances poll the same table each delivery is sent only once.
+func (s *WebhookService) deliver(ctx context.Context, d *ent.WebhookDelivery) {
+	claimed, err := s.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.IDEQ(d.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+		).
+		SetStatus(webhookdelivery.StatusSending).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return
+	}
+	attempts := d.Attempts + 1
+
+	update := s.client.WebhookDelivery.UpdateOneID(d.ID)
+
+	wh := d.Edges.Webhook
+	var statusCode int
+	var sendErr error
+	if wh.Active {
+		statusCode, sendErr = s.post(ctx, wh, d)
+	}
+	if statusCode != 0 {
+		update.SetStatusCode(statusCode)
+	}
+
+	switch {
+	case !wh.Active:
+		// Deactivated after the delivery was queued
+		update.SetStatus(webhookdelivery.StatusFailed).
+			SetLastError("webhook is inactive")
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearLastError()
+	case attempts > s.maxRetries:
+		log.Printf("[WebhookService] Failed to deliver %s to webhook %s after %d attempt(s): %v", d.Event, wh.ID, attempts, sendErr)
+		update.SetStatus(webhookdelivery.StatusFailed).
+			SetLastError(sendErr.Error())
+	default:
+		// Back off exponentially: retryDelay, then twice that, and so on
+		delay := s.retryDelay << (attempts - 1)
+		update.SetStatus(webhookdelivery.StatusPending).
+			SetLastError(sendErr.Error()).
+			SetNextAttemptAt(time.Now().Add(delay))
+	}
+
+	// Record the outcome even if shutdown has begun, so a delivered payload is not sent again
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("[WebhookService] Failed to update delivery %s: %v", d.ID, err)
+	}
+}
+
+// post sends a delivery's payload to its webhook. Any response other than 2xx is a failure.
+func (s *WebhookService) post(ctx context.Context, wh *ent.Webhook, d *ent.WebhookDelivery) (int, error) {
+	payload := []byte(d.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "TeamTodo-Webhook/1.0")
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(wh.Secret, payload))
+	req.Header.Set(WebhookEventHeader, d.Event)
+	req.Header.Set(WebhookDeliveryHeader, d.ID.String())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"backend/ent"
+
+	"github.com/google/uuid"
+)
+
+func TestPublicWebhookAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"203.0.114.10", true},
+		{"2001:db8::1", true},
+		{"127.0.0.1", false},
+		{"10.0.0.1", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:10.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := publicWebhookAddress(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("publicWebhookAddress(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestPostRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	s := NewWebhookService(nil)
+	wh := &ent.Webhook{ID: uuid.New(), URL: server.URL, Secret: "secret"}
+	d := &ent.WebhookDelivery{ID: uuid.New(), Event: "task.created", Payload: "{}"}
+	if _, err := s.post(context.Background(), wh, d); !errors.Is(err, ErrPrivateWebhookAddress) {
+		t.Errorf("error %v, want %v", err, ErrPrivateWebhookAddress)
+	}
+	if called {
+		t.Error("the request reached the loopback server")
+	}
+}
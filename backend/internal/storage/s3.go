@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// s3RequestExpiry is how long the URLs the server signs for its own requests stay valid
+const s3RequestExpiry = time.Minute
+
+// S3Config configures an S3Store
+type S3Config struct {
+	Bucket string
+	Region string // defaults to us-east-1
+	// Endpoint is the base URL of an S3-compatible service such as MinIO, whose objects are
+	// then addressed path-style. Empty means AWS.
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // only set for temporary credentials
+}
+
+// S3Store is a Store backed by an S3 bucket. Requests are signed with AWS Signature Version 4
+// as presigned URLs, which the server also uses for its own HEAD and DELETE requests.
+type S3Store struct {
+	cfg        S3Config
+	scheme     string
+	host       string
+	pathPrefix string // "/bucket" for path-style endpoints, empty for virtual-hosted AWS
+	httpClient *http.Client
+}
+
+// NewS3Store creates a store for the configured bucket
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, errors.New("S3 access key id and secret access key are required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	s := &S3Store{
+		cfg:        cfg,
+		scheme:     "https",
+		host:       fmt.Sprintf("%s.s3.%s.amazonaws.com", cfg.Bucket, cfg.Region),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+	if cfg.Endpoint != "" {
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid S3 endpoint %q", cfg.Endpoint)
+		}
+		s.scheme = u.Scheme
+		s.host = u.Host
+		s.pathPrefix = "/" + cfg.Bucket
+	}
+	return s, nil
+}
+
+// PresignUpload implements Store. The content length is signed, so S3 rejects an upload of
+// any other size.
+func (s *S3Store) PresignUpload(_ context.Context, key, contentType string, size int64, expiry time.Duration) (string, map[string]string, error) {
+	headers := map[string]string{
+		"Content-Type":   contentType,
+		"Content-Length": strconv.FormatInt(size, 10),
+	}
+	u := s.presign(http.MethodPut, key, time.Now(), expiry, headers, nil)
+	// Browsers set Content-Length themselves and refuse to let scripts send it
+	return u, map[string]string{"Content-Type": contentType}, nil
+}
+
+// PresignDownload implements Store
+func (s *S3Store) PresignDownload(_ context.Context, key, filename string, expiry time.Duration) (string, error) {
+	query := url.Values{}
+	query.Set("response-content-disposition", "attachment; filename*=UTF-8''"+awsEscape(filename, false))
+	return s.presign(http.MethodGet, key, time.Now(), expiry, nil, query), nil
+}
+
+// Size implements Store
+func (s *S3Store) Size(ctx context.Context, key string) (int64, error) {
+	resp, err := s.do(ctx, http.MethodHead, key)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return 0, ErrNotFound
+	case resp.StatusCode/100 != 2:
+		return 0, fmt.Errorf("S3 HEAD %s returned %d", key, resp.StatusCode)
+	}
+	return resp.ContentLength, nil
+}
+
+// Delete implements Store
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("S3 DELETE %s returned %d", key, resp.StatusCode)
+	}
+	return nil
+}
+
+// do sends a bodyless request for an object through a freshly presigned URL
+func (s *S3Store) do(ctx context.Context, method, key string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.presign(method, key, time.Now(), s3RequestExpiry, nil, nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.httpClient.Do(req)
+}
+
+// presign builds a Signature Version 4 query-string signed URL for an object, valid for expiry
+// from now. The host and the given headers are signed, so the request must send them with
+// these exact values.
+func (s *S3Store) presign(method, key string, now time.Time, expiry time.Duration, headers map[string]string, query url.Values) string {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+
+	canonicalURI := awsEscape(s.pathPrefix+"/"+key, true)
+
+	signed := map[string]string{"host": s.host}
+	for name, value := range headers {
+		signed[strings.ToLower(name)] = strings.TrimSpace(value)
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	params := url.Values{}
+	for k, v := range query {
+		params[k] = v
+	}
+	params.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	params.Set("X-Amz-Credential", s.cfg.AccessKeyID+"/"+scope)
+	params.Set("X-Amz-Date", amzDate)
+	params.Set("X-Amz-Expires", strconv.Itoa(int(expiry.Seconds())))
+	params.Set("X-Amz-SignedHeaders", signedHeaders)
+	if s.cfg.SessionToken != "" {
+		params.Set("X-Amz-Security-Token", s.cfg.SessionToken)
+	}
+	canonicalQuery := canonicalQueryString(params)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI,
+		canonicalQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return s.scheme + "://" + s.host + canonicalURI + "?" + canonicalQuery + "&X-Amz-Signature=" + signature
+}
+
+// canonicalQueryString encodes query parameters sorted by name as Signature Version 4 expects
+func canonicalQueryString(params url.Values) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pairs []string
+	for _, name := range names {
+		values := append([]string(nil), params[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, awsEscape(name, false)+"="+awsEscape(value, false))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// awsEscape percent-encodes everything but unreserved characters, and slashes if keepSlash is
+// set. This is stricter than net/url, which leaves characters such as '+' and '!' alone.
+func awsEscape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			b.WriteByte(ch)
+		case ch == '/' && keepSlash:
+			b.WriteByte(ch)
+		default:
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+)
+
+// ErrNotFound is returned when an object doesn't exist in the store
+var ErrNotFound = errors.New("object not found")
+
+// Store keeps uploaded files outside the database. Clients upload and download directly
+// through presigned URLs, so file contents never pass through the API; other backends
+// such as GCS or a local disk can implement the same interface.
+type Store interface {
+	// PresignUpload returns a URL the client can PUT a file of the given type and size to
+	// until the expiry passes, along with the headers it must send
+	PresignUpload(ctx context.Context, key, contentType string, size int64, expiry time.Duration) (url string, headers map[string]string, err error)
+	// PresignDownload returns a URL the client can GET the object from until the expiry
+	// passes. The response asks browsers to save it under filename.
+	PresignDownload(ctx context.Context, key, filename string, expiry time.Duration) (string, error)
+	// Size returns the size in bytes of a stored object, or ErrNotFound
+	Size(ctx context.Context, key string) (int64, error)
+	// Delete removes an object. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewFromEnv creates the store configured by the environment. It returns nil when
+// S3_BUCKET is not set, in which case attachments are disabled.
+func NewFromEnv() (Store, error) {
+	bucket := os.Getenv("S3_BUCKET")
+	if bucket == "" {
+		return nil, nil
+	}
+	store, err := NewS3Store(S3Config{
+		Bucket:          bucket,
+		Region:          os.Getenv("S3_REGION"),
+		Endpoint:        os.Getenv("S3_ENDPOINT"),
+		AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("S3_SESSION_TOKEN"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
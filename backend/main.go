@@ -4,49 +4,60 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Embed the IANA time zone database for user timezones
 
 	"backend/ent"
+	_ "backend/ent/runtime" // Schema defaults, validators and interceptors
 	"backend/internal/auth"
 	"backend/internal/handler"
+	"backend/internal/logging"
+	"backend/internal/ratelimit"
 	"backend/internal/service"
+	"backend/internal/storage"
 
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
 )
 
 func main() {
+	// Log as JSON lines; the standard log package is routed through the same handler
+	logger := logging.NewLogger()
+	slog.SetDefault(logger)
+
 	e := echo.New()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	ipExtractor, err := clientIPExtractor(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
+	e.IPExtractor = ipExtractor
 
 	// Middleware
-	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus:   true,
-		LogURI:      true,
-		LogMethod:   true,
-		LogLatency:  true,
-		LogError:    true,
-		HandleError: true,
-		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			if v.Error != nil {
-				log.Printf("[%s] %s %d %v - %s", v.Method, v.URI, v.Status, v.Latency, v.Error)
-			} else {
-				log.Printf("[%s] %s %d %v", v.Method, v.URI, v.Status, v.Latency)
-			}
-			return nil
-		},
-	}))
+	e.Use(logging.Middleware(logger))
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000", os.Getenv("FRONTEND_URL")},
 		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, logging.RequestIDHeader},
+		ExposeHeaders:    []string{logging.RequestIDHeader},
 		AllowCredentials: true,
 	}))
+	if getEnv("SECURITY_HEADERS_ENABLED", "true") == "true" {
+		e.Use(middleware.SecureWithConfig(securityHeadersConfig()))
+	}
 
 	// Database connection
 	dbHost := getEnv("DB_HOST", "localhost")
@@ -65,27 +76,60 @@ func main() {
 
 	// Run auto migration
 	ctx := context.Background()
-	if err := client.Schema.Create(ctx); err != nil {
+	if err := client.Schema.Create(ctx, schema.WithApplyHook(handler.DedupeProjectNames)); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 	log.Println("Database migration completed successfully")
 
+	// Data backfills for columns added after rows already existed
+	if err := handler.BackfillProjectPositions(ctx, client); err != nil {
+		log.Fatalf("failed backfilling project positions: %v", err)
+	}
+	if err := handler.BackfillTaskPositions(ctx, client); err != nil {
+		log.Fatalf("failed backfilling task positions: %v", err)
+	}
+	if err := handler.BackfillDefaultProjects(ctx, client); err != nil {
+		log.Fatalf("failed backfilling default projects: %v", err)
+	}
+
 	// Initialize services
-	jwtService := auth.NewJWTService()
-	emailService := service.NewEmailService()
+	jwtService, err := auth.NewJWTService(auth.NewTokenRevocations(client))
+	if err != nil {
+		log.Fatalf("failed configuring JWT signing keys: %v", err)
+	}
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		log.Fatalf("failed creating email service: %v", err)
+	}
+	webhookService := service.NewWebhookService(client)
+	// Attachments are disabled unless S3_BUCKET is set
+	attachmentStore, err := storage.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed configuring attachment storage: %v", err)
+	}
+	// Failed logins per IP and per email, and registrations per IP, within a 15 minute window
+	loginAttempts := ratelimit.NewAttemptLimiter(
+		ratelimit.NewMemoryAttemptStore(),
+		getEnvInt("LOGIN_MAX_ATTEMPTS", 5),
+		15*time.Minute,
+	)
 
 	// Initialize handlers
-	authHandler := handler.NewAuthHandler(client, jwtService, emailService)
+	authHandler := handler.NewAuthHandler(client, jwtService, emailService, loginAttempts)
 	orgHandler := handler.NewOrganizationHandler(client, emailService)
 	projectHandler := handler.NewProjectHandler(client)
-	contextHandler := handler.NewContextHandler(client)
+	taskHandler := handler.NewTaskHandler(client, emailService, attachmentStore)
+	searchHandler := handler.NewSearchHandler(client)
+	contextHandler := handler.NewContextHandler(client, jwtService)
+	notificationHandler := handler.NewNotificationHandler(client)
+	calendarHandler := handler.NewCalendarHandler(client, jwtService)
+	bootstrapHandler := handler.NewBootstrapHandler(client)
+	healthHandler := handler.NewHealthHandler(client)
 
-	// Health check endpoint
-	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(http.StatusOK, map[string]string{
-			"status": "ok",
-		})
-	})
+	// Health checks: /health/live for liveness probes, /health and /health/ready also check the database
+	e.GET("/health", healthHandler.Ready)
+	e.GET("/health/live", healthHandler.Live)
+	e.GET("/health/ready", healthHandler.Ready)
 
 	// API routes
 	api := e.Group("/api/v1")
@@ -97,22 +141,53 @@ func main() {
 		})
 	})
 
+	// API description and a browsable UI for it
+	api.GET("/openapi.json", handler.OpenAPISpec)
+	e.GET("/docs", handler.SwaggerUI)
+	e.GET("/docs/swagger-init.js", handler.SwaggerUIScript)
+	e.GET("/docs/swagger-ui.css", handler.SwaggerUIAsset("swagger-ui.css"))
+	e.GET("/docs/swagger-ui-bundle.js", handler.SwaggerUIAsset("swagger-ui-bundle.js"))
+
 	// Auth routes (public)
 	authGroup := api.Group("/auth")
 	authGroup.POST("/register", authHandler.Register)
 	authGroup.POST("/login", authHandler.Login)
 	authGroup.POST("/refresh", authHandler.RefreshToken)
+	authGroup.POST("/logout", authHandler.Logout)
+	authGroup.POST("/introspect", authHandler.Introspect)
+	authGroup.DELETE("/me", authHandler.DeleteMe, auth.AuthMiddleware(jwtService))
+	// Account deletion is also served at /me; like /auth/me it takes a JWT only, never an API key
+	api.DELETE("/me", authHandler.DeleteMe, auth.AuthMiddleware(jwtService))
+	authGroup.PUT("/password", authHandler.ChangePassword, auth.AuthMiddleware(jwtService))
 
 	// Invite info (public - for showing invite details before login)
 	api.GET("/invites/:token", orgHandler.GetInviteInfo)
 
+	// Slug availability while filling in the organization form, before or after signing in
+	api.GET("/organizations/check-slug", orgHandler.CheckSlug, auth.OptionalAuthMiddleware(jwtService))
+
+	// Calendar feed (calendar apps authenticate with a ?token= from /calendar-token)
+	api.GET("/organizations/:slug/tasks.ics", calendarHandler.GetCalendarFeed, auth.OptionalAuthMiddleware(jwtService))
+
 	// Protected routes
 	protected := api.Group("")
-	protected.Use(auth.AuthMiddleware(jwtService))
+	// Users sign in with a JWT; integrations may use an organization API key instead
+	protected.Use(auth.APIKeyMiddleware(auth.NewAPIKeys(client), jwtService))
+	// Per-user daily request quota (unlimited unless USER_DAILY_REQUEST_QUOTA is set)
+	protected.Use(ratelimit.UserQuotaMiddleware(ratelimit.NewUserQuota(getEnvInt("USER_DAILY_REQUEST_QUOTA", 0))))
 
 	// User routes
 	protected.GET("/me", authHandler.GetMe)
 	protected.PATCH("/me", authHandler.UpdateMe)
+	protected.GET("/me/invites", orgHandler.ListMyInvites)
+
+	// Notification routes
+	protected.GET("/me/notifications", notificationHandler.ListNotifications)
+	protected.POST("/me/notifications/read-all", notificationHandler.MarkAllNotificationsRead)
+	protected.POST("/me/notifications/:id/read", notificationHandler.MarkNotificationRead)
+
+	// Bootstrap (initial app state in one request)
+	protected.GET("/bootstrap", bootstrapHandler.GetBootstrap)
 
 	// Context routes
 	protected.GET("/context", contextHandler.GetCurrentContext)
@@ -122,14 +197,80 @@ func main() {
 	protected.POST("/organizations", orgHandler.CreateOrganization)
 	protected.GET("/organizations", orgHandler.ListOrganizations)
 	protected.GET("/organizations/:slug", orgHandler.GetOrganization)
+	protected.PATCH("/organizations/:slug", orgHandler.UpdateOrganization)
+	protected.DELETE("/organizations/:slug", orgHandler.DeleteOrganization)
+	protected.PATCH("/organizations/:slug/features", orgHandler.UpdateFeatureFlags)
+	protected.GET("/organizations/:slug/me", orgHandler.GetMyMembership)
+	protected.GET("/organizations/:slug/member-stats", orgHandler.GetMemberStats)
+	protected.GET("/organizations/:slug/activity", orgHandler.ListActivity)
+	protected.POST("/organizations/:slug/webhooks", orgHandler.CreateWebhook)
+	protected.GET("/organizations/:slug/webhooks", orgHandler.ListWebhooks)
+	protected.GET("/organizations/:slug/webhooks/:webhook_id", orgHandler.GetWebhook)
+	protected.PATCH("/organizations/:slug/webhooks/:webhook_id", orgHandler.UpdateWebhook)
+	protected.DELETE("/organizations/:slug/webhooks/:webhook_id", orgHandler.DeleteWebhook)
+	protected.GET("/organizations/:slug/webhooks/:webhook_id/deliveries", orgHandler.ListWebhookDeliveries)
+	protected.POST("/organizations/:slug/api-keys", orgHandler.CreateAPIKey)
+	protected.GET("/organizations/:slug/api-keys", orgHandler.ListAPIKeys)
+	protected.DELETE("/organizations/:slug/api-keys/:key_id", orgHandler.RevokeAPIKey)
+	protected.GET("/organizations/:slug/search", searchHandler.SearchTasks)
+	protected.POST("/organizations/:slug/tasks/bulk", taskHandler.BulkUpdateTasks)
+	protected.POST("/organizations/:slug/calendar-token", calendarHandler.CreateCalendarToken)
 	protected.POST("/organizations/:slug/invites", orgHandler.InviteMember)
+	protected.POST("/organizations/:slug/invites/bulk", orgHandler.BulkInvite)
+	protected.GET("/organizations/:slug/invites", orgHandler.ListInvites)
+	protected.DELETE("/organizations/:slug/invites/:invite_id", orgHandler.RevokeInvite)
+	protected.PUT("/organizations/:slug/members/:user_id/read-only", orgHandler.SetMemberReadOnly)
 	protected.POST("/invites/:token/accept", orgHandler.AcceptInvite)
 
 	// Project routes
+	protected.GET("/projects", projectHandler.ListAllProjects)
 	protected.POST("/organizations/:slug/projects", projectHandler.CreateProject)
 	protected.GET("/organizations/:slug/projects", projectHandler.ListProjects)
 	protected.GET("/organizations/:slug/projects/:project_id", projectHandler.GetProject)
+	protected.PATCH("/organizations/:slug/projects/:project_id", projectHandler.UpdateProject)
+	protected.DELETE("/organizations/:slug/projects/:project_id", projectHandler.DeleteProject)
+	protected.POST("/organizations/:slug/projects/:project_id/restore", projectHandler.RestoreProject)
 	protected.POST("/organizations/:slug/projects/:project_id/members", projectHandler.AddProjectMember)
+	protected.PATCH("/organizations/:slug/projects/:project_id/members/:user_id", projectHandler.UpdateProjectMember)
+	protected.DELETE("/organizations/:slug/projects/:project_id/members/:user_id", projectHandler.RemoveProjectMember)
+	protected.POST("/organizations/:slug/projects/:project_id/reorder", projectHandler.ReorderProject)
+	protected.POST("/organizations/:slug/projects/:project_id/labels", taskHandler.CreateLabel)
+	protected.GET("/organizations/:slug/projects/:project_id/labels", taskHandler.ListLabels)
+
+	// Task routes
+	protected.POST("/organizations/:slug/projects/:project_id/tasks", taskHandler.CreateTask)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks", taskHandler.ListTasks)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/export.csv", taskHandler.ExportTasks)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/:task_id", taskHandler.GetTask)
+	protected.PATCH("/organizations/:slug/projects/:project_id/tasks/:task_id", taskHandler.UpdateTask)
+	protected.DELETE("/organizations/:slug/projects/:project_id/tasks/:task_id", taskHandler.DeleteTask)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/restore", taskHandler.RestoreTask)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/move", taskHandler.MoveTask)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/reorder", taskHandler.ReorderTask)
+	protected.PUT("/organizations/:slug/projects/:project_id/tasks/:task_id/assignee", taskHandler.AssignTask)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/:task_id/history", taskHandler.GetTaskHistory)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/comments", taskHandler.CreateComment)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/:task_id/comments", taskHandler.ListComments)
+	protected.DELETE("/organizations/:slug/projects/:project_id/tasks/:task_id/comments/:comment_id", taskHandler.DeleteComment)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/attachments/uploads", taskHandler.RequestAttachmentUpload)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/attachments", taskHandler.CreateAttachment)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/:task_id/attachments", taskHandler.ListAttachments)
+	protected.DELETE("/organizations/:slug/projects/:project_id/tasks/:task_id/attachments/:attachment_id", taskHandler.DeleteAttachment)
+	protected.GET("/organizations/:slug/projects/:project_id/tasks/:task_id/watchers", taskHandler.ListWatchers)
+	protected.POST("/organizations/:slug/projects/:project_id/tasks/:task_id/watch", taskHandler.WatchTask)
+	protected.DELETE("/organizations/:slug/projects/:project_id/tasks/:task_id/watch", taskHandler.UnwatchTask)
+	protected.PUT("/organizations/:slug/projects/:project_id/tasks/:task_id/labels/:label_id", taskHandler.AttachLabel)
+	protected.DELETE("/organizations/:slug/projects/:project_id/tasks/:task_id/labels/:label_id", taskHandler.DetachLabel)
+
+	// Deliver queued emails and webhooks in the background
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	var workers sync.WaitGroup
+	workers.Go(func() {
+		emailService.RunWorker(workerCtx)
+	})
+	workers.Go(func() {
+		webhookService.RunWorker(workerCtx)
+	})
 
 	// Start server in a goroutine
 	port := getEnv("PORT", "8080")
@@ -157,6 +298,10 @@ func main() {
 		log.Printf("Error during server shutdown: %v", err)
 	}
 
+	// Stop the workers before the database goes away
+	stopWorker()
+	workers.Wait()
+
 	// Close database connection
 	if err := client.Close(); err != nil {
 		log.Printf("Error closing database connection: %v", err)
@@ -171,3 +316,71 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// securityHeadersConfig builds the security response headers from the environment.
+// Setting a header variable to "off" drops that header.
+func securityHeadersConfig() middleware.SecureConfig {
+	// HSTS is only meaningful behind TLS, so it defaults on in production only
+	hstsMaxAge := 0
+	if os.Getenv("APP_ENV") == "production" {
+		hstsMaxAge = 31536000
+	}
+
+	return middleware.SecureConfig{
+		ContentTypeNosniff:    getEnvHeader("SECURITY_CONTENT_TYPE_OPTIONS", "nosniff"),
+		XFrameOptions:         getEnvHeader("SECURITY_FRAME_OPTIONS", "DENY"),
+		ReferrerPolicy:        getEnvHeader("SECURITY_REFERRER_POLICY", "strict-origin-when-cross-origin"),
+		ContentSecurityPolicy: getEnvHeader("SECURITY_CONTENT_SECURITY_POLICY", "default-src 'none'; frame-ancestors 'none'"),
+		HSTSMaxAge:            getEnvInt("SECURITY_HSTS_MAX_AGE", hstsMaxAge),
+	}
+}
+
+// clientIPExtractor decides which address a request comes from, for per-IP limits and logs.
+// Clients can send any X-Forwarded-For, so by default the header is ignored and the address
+// is the peer of the connection. Behind a reverse proxy, trustedProxies lists the proxies'
+// addresses or CIDR ranges, comma-separated, and the header is followed back through them only.
+func clientIPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	if strings.TrimSpace(trustedProxies) == "" {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range strings.Split(trustedProxies, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, err
+			}
+			proxy = netip.PrefixFrom(addr, addr.BitLen()).String()
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+func getEnvHeader(key, defaultValue string) string {
+	value := getEnv(key, defaultValue)
+	if value == "off" {
+		return ""
+	}
+	return value
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+		log.Printf("Invalid integer for %s: %q, using default %d", key, value, defaultValue)
+	}
+	return defaultValue
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/ent/enttest"
+	_ "backend/ent/runtime"
+	"backend/internal/auth"
+	"backend/internal/handler"
+	"backend/internal/ratelimit"
+	"backend/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// securityHeaders returns the headers the security middleware sets on a response to req
+func securityHeaders(t *testing.T, req *http.Request) http.Header {
+	t.Helper()
+	e := echo.New()
+	e.Use(middleware.SecureWithConfig(securityHeadersConfig()))
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusNoContent) })
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Header()
+}
+
+func TestSecurityHeadersDefaults(t *testing.T) {
+	h := securityHeaders(t, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	want := map[string]string{
+		echo.HeaderXContentTypeOptions:     "nosniff",
+		echo.HeaderXFrameOptions:           "DENY",
+		echo.HeaderReferrerPolicy:          "strict-origin-when-cross-origin",
+		echo.HeaderContentSecurityPolicy:   "default-src 'none'; frame-ancestors 'none'",
+		echo.HeaderStrictTransportSecurity: "",
+	}
+	for name, value := range want {
+		if got := h.Get(name); got != value {
+			t.Errorf("%s: %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestSecurityHeadersOverrides(t *testing.T) {
+	t.Setenv("SECURITY_FRAME_OPTIONS", "SAMEORIGIN")
+	t.Setenv("SECURITY_CONTENT_SECURITY_POLICY", "off")
+	h := securityHeaders(t, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if got := h.Get(echo.HeaderXFrameOptions); got != "SAMEORIGIN" {
+		t.Errorf("%s: %q, want the override", echo.HeaderXFrameOptions, got)
+	}
+	if _, ok := h[echo.HeaderContentSecurityPolicy]; ok {
+		t.Errorf("%s sent although turned off", echo.HeaderContentSecurityPolicy)
+	}
+}
+
+func TestSecurityHeadersHSTS(t *testing.T) {
+	t.Setenv("APP_ENV", "production")
+
+	if got := securityHeaders(t, httptest.NewRequest(http.MethodGet, "/", nil)).Get(echo.HeaderStrictTransportSecurity); got != "" {
+		t.Errorf("HSTS on a plain HTTP request: %q", got)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.TLS = &tls.ConnectionState{}
+	want := "max-age=31536000; includeSubdomains"
+	if got := securityHeaders(t, req).Get(echo.HeaderStrictTransportSecurity); got != want {
+		t.Errorf("HSTS over TLS in production: %q, want %q", got, want)
+	}
+
+	t.Setenv("APP_ENV", "development")
+	if got := securityHeaders(t, req).Get(echo.HeaderStrictTransportSecurity); got != "" {
+		t.Errorf("HSTS outside production: %q", got)
+	}
+}
+
+// registerServer serves the register endpoint behind the client IP extractor for trustedProxies,
+// allowing two registration attempts per IP
+func registerServer(t *testing.T, trustedProxies string) *echo.Echo {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") + "?_fk=1&_busy_timeout=5000"
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	jwtService, err := auth.NewJWTService(auth.NewTokenRevocations(client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	emailService, err := service.NewEmailService(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := ratelimit.NewAttemptLimiter(ratelimit.NewMemoryAttemptStore(), 2, time.Minute)
+	authHandler := handler.NewAuthHandler(client, jwtService, emailService, attempts)
+
+	e := echo.New()
+	e.HTTPErrorHandler = handler.HTTPErrorHandler
+	if e.IPExtractor, err = clientIPExtractor(trustedProxies); err != nil {
+		t.Fatal(err)
+	}
+	e.POST("/register", authHandler.Register)
+	return e
+}
+
+// register posts a registration for the i-th user from remoteAddr with an X-Forwarded-For header
+func register(e *echo.Echo, i int, remoteAddr, forwardedFor string) int {
+	body := fmt.Sprintf(`{"email":"user%d@example.com","password":"correct horse 1","display_name":"User"}`, i)
+	req := httptest.NewRequest(http.MethodPost, "/register", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXForwardedFor, forwardedFor)
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestRegisterLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	e := registerServer(t, "")
+
+	for i := range 2 {
+		if status := register(e, i, "203.0.113.7:4321", fmt.Sprintf("198.51.100.%d", i)); status != http.StatusCreated {
+			t.Fatalf("attempt %d: status %d, want %d", i, status, http.StatusCreated)
+		}
+	}
+	// A new X-Forwarded-For on each request doesn't make the client a new one
+	if status := register(e, 2, "203.0.113.7:4321", "198.51.100.2"); status != http.StatusTooManyRequests {
+		t.Errorf("attempt over the limit: status %d, want %d", status, http.StatusTooManyRequests)
+	}
+	if status := register(e, 3, "203.0.113.8:4321", "198.51.100.2"); status != http.StatusCreated {
+		t.Errorf("another client: status %d, want %d", status, http.StatusCreated)
+	}
+}
+
+func TestRegisterLimitBehindTrustedProxy(t *testing.T) {
+	e := registerServer(t, "10.0.0.0/8, 192.0.2.1")
+
+	// Clients behind the proxy are told apart by the address it forwards
+	for i := range 3 {
+		if status := register(e, i, "192.0.2.1:4321", fmt.Sprintf("198.51.100.%d", i)); status != http.StatusCreated {
+			t.Fatalf("client %d behind the proxy: status %d, want %d", i, status, http.StatusCreated)
+		}
+	}
+	// Addresses prepended by the client are skipped up to the first untrusted hop
+	for i := 3; i < 5; i++ {
+		if status := register(e, i, "10.1.2.3:4321", fmt.Sprintf("198.51.100.%d, 198.51.100.9", i)); status != http.StatusCreated {
+			t.Fatalf("attempt %d: status %d, want %d", i, status, http.StatusCreated)
+		}
+	}
+	if status := register(e, 5, "10.1.2.3:4321", "198.51.100.5, 198.51.100.9"); status != http.StatusTooManyRequests {
+		t.Errorf("attempt over the limit: status %d, want %d", status, http.StatusTooManyRequests)
+	}
+	// Requests that don't come through a trusted proxy keep their own address
+	if status := register(e, 6, "203.0.113.7:4321", "198.51.100.9"); status != http.StatusCreated {
+		t.Errorf("direct client: status %d, want %d", status, http.StatusCreated)
+	}
+}
+
+func TestClientIPExtractorRejectsInvalidProxies(t *testing.T) {
+	for _, proxies := range []string{"proxy.internal", "10.0.0.0/33", "10.0.0.1,"} {
+		if _, err := clientIPExtractor(proxies); err == nil {
+			t.Errorf("clientIPExtractor(%q) succeeded, want an error", proxies)
+		}
+	}
+}
@@ -15,6 +15,7 @@ export default function NewOrganizationPage() {
   const [slug, setSlug] = useState('');
   const [isSlugManual, setIsSlugManual] = useState(false);
   const [error, setError] = useState('');
+  const [slugError, setSlugError] = useState('');
   const [isLoading, setIsLoading] = useState(false);
 
   // Redirect if not authenticated
@@ -31,6 +32,32 @@ export default function NewOrganizationPage() {
     }
   }, [name, isSlugManual]);
 
+  // Check the slug while the user types, once they pause
+  useEffect(() => {
+    setSlugError('');
+    if (!slug) return;
+
+    let cancelled = false;
+    const timer = setTimeout(async () => {
+      try {
+        const result = await organizationAPI.checkSlug(slug);
+        if (cancelled || result.available) return;
+        setSlugError(
+          result.reason === 'taken'
+            ? 'このURLスラッグはすでに使用されています。'
+            : 'URLスラッグには小文字の英数字とハイフンのみ使用できます。'
+        );
+      } catch {
+        // The create request reports the problem if the check couldn't run
+      }
+    }, 300);
+
+    return () => {
+      cancelled = true;
+      clearTimeout(timer);
+    };
+  }, [slug]);
+
   const handleSlugChange = (value: string) => {
     setIsSlugManual(true);
     setSlug(slugify(value));
@@ -45,15 +72,10 @@ export default function NewOrganizationPage() {
       return;
     }
 
-    if (!slug.trim()) {
-      setError('URLスラッグを入力してください。');
-      return;
-    }
-
     setIsLoading(true);
 
     try {
-      const org = await organizationAPI.create(name.trim(), slug.trim());
+      const org = await organizationAPI.create(name.trim(), slug.trim() || undefined);
       router.push(`/org/${org.slug}`);
     } catch (err) {
       if (err instanceof APIError) {
@@ -137,10 +159,10 @@ export default function NewOrganizationPage() {
                 placeholder="my-team"
                 value={slug}
                 onChange={(e) => handleSlugChange(e.target.value)}
-                required
+                error={slugError}
               />
               <p className="mt-2 text-sm text-foreground-tertiary">
-                組織のURLは <code className="px-1.5 py-0.5 rounded bg-background-tertiary text-foreground-secondary">teamtodo.com/org/{slug || 'your-slug'}</code> になります
+                組織のURLは <code className="px-1.5 py-0.5 rounded bg-background-tertiary text-foreground-secondary">teamtodo.com/org/{slug || 'your-slug'}</code> になります。空欄の場合は組織名から自動で作成されます
               </p>
             </div>
 
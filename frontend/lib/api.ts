@@ -25,15 +25,27 @@ export interface Organization {
   created_at: string;
 }
 
+export interface SlugAvailability {
+  available: boolean;
+  reason?: 'invalid_format' | 'taken';
+}
+
 export interface Project {
   id: string;
   name: string;
   is_private: boolean;
   organization_id: string;
   permission?: string;
+  created_by_id: string | null;
+  created_by: string | null;
   created_at: string;
 }
 
+export interface ProjectListResponse {
+  projects: Project[];
+  next_cursor: string | null;
+}
+
 export interface ContextResponse {
   has_context: boolean;
   organization?: Organization;
@@ -41,6 +53,38 @@ export interface ContextResponse {
   redirect_url?: string;
 }
 
+export interface UpdateContextResponse {
+  access_token: string;
+  expires_in: number;
+}
+
+export interface IncomingInvite {
+  id: string;
+  token: string;
+  organization_name: string;
+  organization_slug: string;
+  invited_by_name: string;
+  role: string;
+  project_id?: string;
+  project_permission?: 'edit' | 'view';
+  expires_at: string;
+  created_at: string;
+}
+
+export interface Notification {
+  id: string;
+  type: 'invite.accepted' | 'task.assigned' | 'comment.mentioned';
+  payload?: Record<string, unknown>;
+  read_at: string | null;
+  created_at: string;
+}
+
+export interface NotificationList {
+  notifications: Notification[];
+  unread_count: number;
+  next_cursor: string | null;
+}
+
 export interface InviteInfo {
   organization_name: string;
   organization_slug: string;
@@ -59,6 +103,12 @@ export class APIError extends Error {
   }
 }
 
+// Error responses have the shape { error: { code, message, fields? } }; fields lists each
+// failed field of a validation error
+const errorMessage = (data: { error?: { message?: string } } | null, fallback: string): string => {
+  return data?.error?.message || fallback;
+};
+
 // Token management
 const TOKEN_KEY = 'team_todo_access_token';
 const REFRESH_TOKEN_KEY = 'team_todo_refresh_token';
@@ -78,6 +128,10 @@ export const setTokens = (accessToken: string, refreshToken: string) => {
   localStorage.setItem(REFRESH_TOKEN_KEY, refreshToken);
 };
 
+export const setAccessToken = (accessToken: string) => {
+  localStorage.setItem(TOKEN_KEY, accessToken);
+};
+
 export const clearTokens = () => {
   localStorage.removeItem(TOKEN_KEY);
   localStorage.removeItem(REFRESH_TOKEN_KEY);
@@ -109,7 +163,7 @@ async function fetchWithAuth<T>(
     let message = 'An error occurred';
     try {
       const data = await response.json();
-      message = data.message || data.error || message;
+      message = errorMessage(data, message);
     } catch {
       // Ignore JSON parse errors
     }
@@ -173,7 +227,7 @@ export const authAPI = {
     
     if (!response.ok) {
       const data = await response.json();
-      throw new APIError(data.message || 'Registration failed', response.status);
+      throw new APIError(errorMessage(data, 'Registration failed'), response.status);
     }
     
     const data: AuthResponse = await response.json();
@@ -190,7 +244,7 @@ export const authAPI = {
     
     if (!response.ok) {
       const data = await response.json();
-      throw new APIError(data.message || 'Login failed', response.status);
+      throw new APIError(errorMessage(data, 'Login failed'), response.status);
     }
     
     const data: AuthResponse = await response.json();
@@ -212,19 +266,28 @@ export const authAPI = {
 export const contextAPI = {
   getCurrent: (): Promise<ContextResponse> => fetchWithAuth('/api/v1/context'),
   
-  update: (data: { org_id?: string; project_id?: string }): Promise<void> =>
-    fetchWithAuth('/api/v1/context', { method: 'PUT', body: JSON.stringify(data) }),
+  // The response carries an access token for the new context
+  update: async (data: { org_id?: string; project_id?: string }): Promise<void> => {
+    const { access_token } = await fetchWithAuth<UpdateContextResponse>('/api/v1/context', {
+      method: 'PUT',
+      body: JSON.stringify(data),
+    });
+    setAccessToken(access_token);
+  },
 };
 
 // Organization API
 export const organizationAPI = {
-  create: (name: string, slug: string): Promise<Organization> =>
+  create: (name: string, slug?: string): Promise<Organization> =>
     fetchWithAuth('/api/v1/organizations', {
       method: 'POST',
       body: JSON.stringify({ name, slug }),
     }),
   
   list: (): Promise<Organization[]> => fetchWithAuth('/api/v1/organizations'),
+
+  checkSlug: (slug: string): Promise<SlugAvailability> =>
+    fetchWithAuth(`/api/v1/organizations/check-slug?slug=${encodeURIComponent(slug)}`),
   
   get: (slug: string): Promise<Organization> =>
     fetchWithAuth(`/api/v1/organizations/${slug}`),
@@ -241,16 +304,30 @@ export const organizationAPI = {
 
 // Invite API (public)
 export const inviteAPI = {
+  listMine: (): Promise<IncomingInvite[]> => fetchWithAuth('/api/v1/me/invites'),
+
   getInfo: async (token: string): Promise<InviteInfo> => {
     const response = await fetch(`${API_URL}/api/v1/invites/${token}`);
     if (!response.ok) {
       const data = await response.json();
-      throw new APIError(data.message || 'Failed to get invite info', response.status);
+      throw new APIError(errorMessage(data, 'Failed to get invite info'), response.status);
     }
     return response.json();
   },
 };
 
+// Notification API
+export const notificationAPI = {
+  list: (unread = false): Promise<NotificationList> =>
+    fetchWithAuth(`/api/v1/me/notifications${unread ? '?unread=true' : ''}`),
+
+  markRead: (id: string): Promise<void> =>
+    fetchWithAuth(`/api/v1/me/notifications/${id}/read`, { method: 'POST' }),
+
+  markAllRead: (): Promise<void> =>
+    fetchWithAuth('/api/v1/me/notifications/read-all', { method: 'POST' }),
+};
+
 // Project API
 export const projectAPI = {
   create: (orgSlug: string, name: string, isPrivate: boolean): Promise<Project> =>
@@ -259,8 +336,18 @@ export const projectAPI = {
       body: JSON.stringify({ name, is_private: isPrivate }),
     }),
   
-  list: (orgSlug: string): Promise<Project[]> =>
-    fetchWithAuth(`/api/v1/organizations/${orgSlug}/projects`),
+  // Follows next_cursor until every page has been fetched
+  list: async (orgSlug: string): Promise<Project[]> => {
+    const projects: Project[] = [];
+    let cursor: string | null = null;
+    do {
+      const query: string = cursor ? `?cursor=${encodeURIComponent(cursor)}` : '';
+      const page: ProjectListResponse = await fetchWithAuth(`/api/v1/organizations/${orgSlug}/projects${query}`);
+      projects.push(...page.projects);
+      cursor = page.next_cursor;
+    } while (cursor);
+    return projects;
+  },
   
   get: (orgSlug: string, projectId: string): Promise<Project> =>
     fetchWithAuth(`/api/v1/organizations/${orgSlug}/projects/${projectId}`),